@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cedar-policy/cedar-go"
+)
+
+// Request is a Cedar authorization request together with the entities it is evaluated against.
+// Entity UIDs are in Cedar JSON format, e.g. `{"type": "PhotoApp::User", "id": "alice"}`.
+type Request struct {
+	cedar.Request
+	Entities cedar.Entities `json:"entities"`
+}
+
+// ParseRequest parses a Cedar authorization request in JSON format.
+func ParseRequest(document string) (*Request, error) {
+	var request Request
+
+	if err := json.Unmarshal([]byte(document), &request); err != nil {
+		return nil, fmt.Errorf("parsing Cedar authorization request: %w", err)
+	}
+
+	if request.Principal.IsZero() {
+		return nil, fmt.Errorf("parsing Cedar authorization request: principal is required")
+	}
+
+	if request.Action.IsZero() {
+		return nil, fmt.Errorf("parsing Cedar authorization request: action is required")
+	}
+
+	if request.Resource.IsZero() {
+		return nil, fmt.Errorf("parsing Cedar authorization request: resource is required")
+	}
+
+	return &request, nil
+}
+
+// IsAuthorized evaluates an authorization request against a Cedar policy document.
+// If schema is not nil, the policies and the request are first validated against it.
+// As in Cedar, policies that fail to evaluate are ignored.
+func IsAuthorized(policies string, schema *Schema, request *Request) (bool, error) {
+	if schema != nil {
+		parsed, err := ParsePolicies(policies)
+
+		if err != nil {
+			return false, err
+		}
+
+		if err := schema.ValidatePolicies(parsed); err != nil {
+			return false, err
+		}
+
+		action := entityUID(request.Action.Type, request.Action.ID)
+		if err := schema.ValidateRequest(request.Principal.Type, action, request.Resource.Type); err != nil {
+			return false, fmt.Errorf("request: %w", err)
+		}
+	}
+
+	policySet, err := cedar.NewPolicySet("policies.cedar", []byte(policies))
+
+	if err != nil {
+		return false, fmt.Errorf("parsing Cedar policy: %w", err)
+	}
+
+	decision, _ := policySet.IsAuthorized(request.Entities, request.Request)
+
+	return decision == cedar.Allow, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsAuthorized(t *testing.T) {
+	t.Parallel()
+
+	const policies = `
+permit (principal in PhotoApp::UserGroup::"family", action == PhotoApp::Action::"viewPhoto", resource in PhotoApp::Album::"vacation");
+forbid (principal, action, resource) when { resource.private && principal != resource.owner };
+`
+	const entities = `[
+  {"uid": {"type": "PhotoApp::User", "id": "alice"}, "parents": [{"type": "PhotoApp::UserGroup", "id": "family"}], "attrs": {}},
+  {"uid": {"type": "PhotoApp::User", "id": "bob"}, "parents": [], "attrs": {}},
+  {"uid": {"type": "PhotoApp::Photo", "id": "beach.jpg"}, "parents": [{"type": "PhotoApp::Album", "id": "vacation"}], "attrs": {"private": false, "owner": {"__entity": {"type": "PhotoApp::User", "id": "bob"}}}},
+  {"uid": {"type": "PhotoApp::Photo", "id": "diary.jpg"}, "parents": [{"type": "PhotoApp::Album", "id": "vacation"}], "attrs": {"private": true, "owner": {"__entity": {"type": "PhotoApp::User", "id": "bob"}}}}
+]`
+
+	testCases := map[string]struct {
+		request string
+		schema  bool
+		want    bool
+		wantErr string
+	}{
+		"allowed": {
+			request: `{"principal": {"type": "PhotoApp::User", "id": "alice"}, "action": {"type": "PhotoApp::Action", "id": "viewPhoto"}, "resource": {"type": "PhotoApp::Photo", "id": "beach.jpg"}, "entities": ` + entities + `}`,
+			want:    true,
+		},
+		"allowed with schema": {
+			request: `{"principal": {"type": "PhotoApp::User", "id": "alice"}, "action": {"type": "PhotoApp::Action", "id": "viewPhoto"}, "resource": {"type": "PhotoApp::Photo", "id": "beach.jpg"}, "entities": ` + entities + `}`,
+			schema:  true,
+			want:    true,
+		},
+		"not permitted": {
+			request: `{"principal": {"type": "PhotoApp::User", "id": "bob"}, "action": {"type": "PhotoApp::Action", "id": "viewPhoto"}, "resource": {"type": "PhotoApp::Photo", "id": "beach.jpg"}, "entities": ` + entities + `}`,
+		},
+		"forbidden": {
+			request: `{"principal": {"type": "PhotoApp::User", "id": "alice"}, "action": {"type": "PhotoApp::Action", "id": "viewPhoto"}, "resource": {"type": "PhotoApp::Photo", "id": "diary.jpg"}, "entities": ` + entities + `}`,
+		},
+		"no entities": {
+			request: `{"principal": {"type": "PhotoApp::User", "id": "alice"}, "action": {"type": "PhotoApp::Action", "id": "viewPhoto"}, "resource": {"type": "PhotoApp::Photo", "id": "beach.jpg"}}`,
+		},
+		"request not valid for schema": {
+			request: `{"principal": {"type": "PhotoApp::User", "id": "alice"}, "action": {"type": "PhotoApp::Action", "id": "listAlbums"}, "resource": {"type": "PhotoApp::Photo", "id": "beach.jpg"}}`,
+			schema:  true,
+			wantErr: "request: action",
+		},
+	}
+
+	schema, err := ParseSchema(testSchema)
+	if err != nil {
+		t.Fatalf("parsing schema: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request, err := ParseRequest(testCase.request)
+			if err != nil {
+				t.Fatalf("parsing request: %s", err)
+			}
+
+			var s *Schema
+			if testCase.schema {
+				s = schema
+			}
+
+			got, err := IsAuthorized(policies, s, request)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Errorf("err = %v, want error containing %q", err, testCase.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request string
+		wantErr bool
+	}{
+		"valid": {
+			request: `{"principal": {"type": "User", "id": "alice"}, "action": {"type": "Action", "id": "view"}, "resource": {"type": "Photo", "id": "a.jpg"}, "context": {"mfa": true}}`,
+		},
+		"entity escape": {
+			request: `{"principal": {"__entity": {"type": "User", "id": "alice"}}, "action": {"type": "Action", "id": "view"}, "resource": {"type": "Photo", "id": "a.jpg"}}`,
+		},
+		"invalid JSON": {
+			request: `{`,
+			wantErr: true,
+		},
+		"missing principal": {
+			request: `{"action": {"type": "Action", "id": "view"}, "resource": {"type": "Photo", "id": "a.jpg"}}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseRequest(testCase.request)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cedar-policy/cedar-go/x/exp/parser"
+)
+
+const (
+	// slotEntityType is the entity type substituted for the ?principal and ?resource slots of a policy template.
+	// The substitutions have the same length as the slots so that error positions are unchanged.
+	slotEntityType = "_"
+	principalSlot  = "?principal"
+	resourceSlot   = "?resource"
+)
+
+var (
+	slotReplacer = strings.NewReplacer(
+		principalSlot, slotEntityType+`::"princ"`,
+		resourceSlot, slotEntityType+`::"reso"`,
+	)
+)
+
+// ParsePolicies parses a Cedar policy document.
+func ParsePolicies(document string) (parser.Policies, error) {
+	tokens, err := parser.Tokenize([]byte(document))
+
+	if err != nil {
+		return nil, fmt.Errorf("tokenizing Cedar policy: %w", err)
+	}
+
+	policies, err := parser.Parse(tokens)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing Cedar policy: %w", err)
+	}
+
+	return policies, nil
+}
+
+// ParsePolicyTemplate parses a Cedar policy template document.
+// The ?principal and ?resource slots are accepted where a policy accepts an entity.
+func ParsePolicyTemplate(document string) (parser.Policies, error) {
+	var sb strings.Builder
+
+	// Substitute slots outside string literals.
+	start, inString := 0, false
+	for i := 0; i < len(document); i++ {
+		switch c := document[i]; {
+		case inString && c == '\\':
+			i++
+		case c == '"' && inString:
+			inString = false
+			sb.WriteString(document[start : i+1])
+			start = i + 1
+		case c == '"':
+			inString = true
+			sb.WriteString(slotReplacer.Replace(document[start:i]))
+			start = i
+		}
+	}
+
+	if inString {
+		sb.WriteString(document[start:])
+	} else {
+		sb.WriteString(slotReplacer.Replace(document[start:]))
+	}
+
+	return ParsePolicies(sb.String())
+}
+
+// policyPosition returns a description of a policy's position for use in error messages.
+func policyPosition(policy parser.Policy) string {
+	return fmt.Sprintf("policy at line %d, column %d", policy.Position.Line, policy.Position.Column)
+}
+
+func entityType(entity parser.Entity) string {
+	return strings.Join(entity.Path[:len(entity.Path)-1], "::")
+}
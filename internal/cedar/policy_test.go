@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"testing"
+)
+
+func TestParsePolicies(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document string
+		want     int
+		wantErr  bool
+	}{
+		"empty": {
+			document: ``,
+		},
+		"single policy": {
+			document: `permit (principal == PhotoApp::User::"alice", action == PhotoApp::Action::"view", resource);`,
+			want:     1,
+		},
+		"multiple policies": {
+			document: `
+permit (principal, action, resource) when { principal.level > 3 };
+forbid (principal, action, resource) unless { context.authenticated };
+`,
+			want: 2,
+		},
+		"missing semicolon": {
+			document: `permit (principal, action, resource)`,
+			wantErr:  true,
+		},
+		"invalid effect": {
+			document: `allow (principal, action, resource);`,
+			wantErr:  true,
+		},
+		"unterminated string": {
+			document: `permit (principal == User::"alice, action, resource);`,
+			wantErr:  true,
+		},
+		"slot": {
+			document: `permit (principal == ?principal, action, resource);`,
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParsePolicies(testCase.document)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			if got, want := len(got), testCase.want; got != want {
+				t.Errorf("got %d policies, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestParsePolicyTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document string
+		wantErr  bool
+	}{
+		"principal slot": {
+			document: `permit (principal == ?principal, action == Action::"view", resource in Album::"vacation");`,
+		},
+		"principal and resource slots": {
+			document: `permit (principal in ?principal, action, resource in ?resource) when { resource.owner != "?principal" };`,
+		},
+		"escaped quote": {
+			document: `permit (principal == ?principal, action, resource) when { principal.name == "a\"b" && resource in ?resource };`,
+		},
+		"invalid": {
+			document: `permit (principal == ?principal, action, resource`,
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParsePolicyTemplate(testCase.document)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/cedar-policy/cedar-go/x/exp/parser"
+)
+
+const (
+	actionEntityType = "Action"
+)
+
+// Schema is a Cedar schema, reduced to what is needed to validate the scope of policies and requests.
+type Schema struct {
+	entityTypes map[string]struct{}
+	actions     map[string]*schemaAction
+}
+
+type schemaAction struct {
+	memberOf       []string
+	appliesTo      bool
+	principalTypes []string
+	resourceTypes  []string
+}
+
+// Cedar schema JSON format.
+// See https://docs.cedarpolicy.com/schema/json-schema.html.
+type schemaNamespaceJSON struct {
+	EntityTypes map[string]schemaEntityTypeJSON `json:"entityTypes"`
+	Actions     map[string]schemaActionJSON     `json:"actions"`
+}
+
+type schemaEntityTypeJSON struct {
+	MemberOfTypes []string `json:"memberOfTypes"`
+}
+
+type schemaActionJSON struct {
+	MemberOf  []schemaActionUIDJSON `json:"memberOf"`
+	AppliesTo *schemaAppliesToJSON  `json:"appliesTo"`
+}
+
+type schemaActionUIDJSON struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type schemaAppliesToJSON struct {
+	PrincipalTypes []string `json:"principalTypes"`
+	ResourceTypes  []string `json:"resourceTypes"`
+}
+
+// ParseSchema parses a Cedar schema in JSON format and checks that all referenced entity types and actions are declared.
+func ParseSchema(document string) (*Schema, error) {
+	var namespaces map[string]schemaNamespaceJSON
+
+	if err := json.Unmarshal([]byte(document), &namespaces); err != nil {
+		return nil, fmt.Errorf("parsing Cedar schema: %w", err)
+	}
+
+	s := &Schema{
+		entityTypes: make(map[string]struct{}),
+		actions:     make(map[string]*schemaAction),
+	}
+
+	for namespace, v := range namespaces {
+		for name := range v.EntityTypes {
+			s.entityTypes[qualify(namespace, name)] = struct{}{}
+		}
+	}
+
+	var errs []error
+
+	for _, namespace := range slices.Sorted(maps.Keys(namespaces)) {
+		v := namespaces[namespace]
+
+		for _, name := range slices.Sorted(maps.Keys(v.EntityTypes)) {
+			for _, typ := range v.EntityTypes[name].MemberOfTypes {
+				if _, err := s.resolveEntityType(namespace, typ); err != nil {
+					errs = append(errs, fmt.Errorf("entity type %s: memberOfTypes: %w", qualify(namespace, name), err))
+				}
+			}
+		}
+
+		for _, id := range slices.Sorted(maps.Keys(v.Actions)) {
+			action := v.Actions[id]
+			uid := entityUID(qualify(namespace, actionEntityType), id)
+			a := &schemaAction{}
+
+			for _, m := range action.MemberOf {
+				typ := qualify(namespace, actionEntityType)
+				if m.Type != "" {
+					typ = m.Type
+					if !strings.Contains(typ, "::") {
+						typ = qualify(namespace, typ)
+					}
+				}
+				a.memberOf = append(a.memberOf, entityUID(typ, m.ID))
+			}
+
+			if appliesTo := action.AppliesTo; appliesTo != nil {
+				a.appliesTo = true
+
+				for _, typ := range appliesTo.PrincipalTypes {
+					typ, err := s.resolveEntityType(namespace, typ)
+
+					if err != nil {
+						errs = append(errs, fmt.Errorf("action %s: principalTypes: %w", uid, err))
+					}
+
+					a.principalTypes = append(a.principalTypes, typ)
+				}
+
+				for _, typ := range appliesTo.ResourceTypes {
+					typ, err := s.resolveEntityType(namespace, typ)
+
+					if err != nil {
+						errs = append(errs, fmt.Errorf("action %s: resourceTypes: %w", uid, err))
+					}
+
+					a.resourceTypes = append(a.resourceTypes, typ)
+				}
+			}
+
+			s.actions[uid] = a
+		}
+	}
+
+	for _, uid := range slices.Sorted(maps.Keys(s.actions)) {
+		for _, m := range s.actions[uid].memberOf {
+			if _, ok := s.actions[m]; !ok {
+				errs = append(errs, fmt.Errorf("action %s: memberOf: undeclared action %s", uid, m))
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ValidatePolicies checks that the entity types and actions in the scope of each policy are declared in the schema,
+// and that at least one action in each policy's scope applies to the policy's principal and resource types.
+func (s *Schema) ValidatePolicies(policies parser.Policies) error {
+	var errs []error
+
+	for _, policy := range policies {
+		if err := s.validatePolicy(policy); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", policyPosition(policy), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Schema) validatePolicy(policy parser.Policy) error {
+	var errs []error
+
+	var actions []*schemaAction
+	switch policy.Action.Type {
+	case parser.MatchAny:
+		for _, uid := range slices.Sorted(maps.Keys(s.actions)) {
+			actions = append(actions, s.actions[uid])
+		}
+	default:
+		for _, entity := range policy.Action.Entities {
+			uid := entity.String()
+
+			if _, ok := s.actions[uid]; !ok {
+				errs = append(errs, fmt.Errorf("undeclared action %s", uid))
+				continue
+			}
+
+			if policy.Action.Type == parser.MatchEquals {
+				actions = append(actions, s.actions[uid])
+			} else {
+				actions = append(actions, s.actionsIn(uid)...)
+			}
+		}
+	}
+
+	principalType, err := s.scopeEntityType(policy.Principal.Type, policy.Principal.Path, policy.Principal.Entity)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("principal: %w", err))
+	}
+
+	resourceType, err := s.scopeEntityType(policy.Resource.Type, policy.Resource.Path, policy.Resource.Entity)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("resource: %w", err))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if !slices.ContainsFunc(actions, func(a *schemaAction) bool {
+		return a.appliesTo && appliesTo(a.principalTypes, principalType) && appliesTo(a.resourceTypes, resourceType)
+	}) {
+		return fmt.Errorf("no action in the policy scope applies to %s", describeScope(principalType, resourceType))
+	}
+
+	return nil
+}
+
+// ValidateRequest checks that an authorization request's principal and resource types and action are declared
+// in the schema and that the action applies to the principal and resource types.
+func (s *Schema) ValidateRequest(principalType, action, resourceType string) error {
+	var errs []error
+
+	a, ok := s.actions[action]
+	if !ok {
+		errs = append(errs, fmt.Errorf("undeclared action %s", action))
+	}
+
+	if !s.hasEntityType(principalType) {
+		errs = append(errs, fmt.Errorf("principal: undeclared entity type %s", principalType))
+	}
+
+	if !s.hasEntityType(resourceType) {
+		errs = append(errs, fmt.Errorf("resource: undeclared entity type %s", resourceType))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if !a.appliesTo || !slices.Contains(a.principalTypes, principalType) || !slices.Contains(a.resourceTypes, resourceType) {
+		return fmt.Errorf("action %s does not apply to %s", action, describeScope(principalType, resourceType))
+	}
+
+	return nil
+}
+
+// scopeEntityType returns the entity type that a principal or resource scope constrains the principal or resource to,
+// or an empty string if the type isn't constrained.
+func (s *Schema) scopeEntityType(matchType parser.MatchType, path parser.Path, entity parser.Entity) (string, error) {
+	var typ string
+
+	switch matchType {
+	case parser.MatchIs, parser.MatchIsIn:
+		typ = path.String()
+
+		if !s.hasEntityType(typ) {
+			return "", fmt.Errorf("undeclared entity type %s", typ)
+		}
+	}
+
+	switch matchType {
+	case parser.MatchEquals, parser.MatchIn, parser.MatchIsIn:
+		t := entityType(entity)
+
+		if t == slotEntityType {
+			break
+		}
+
+		if !s.hasEntityType(t) {
+			return "", fmt.Errorf("undeclared entity type %s", t)
+		}
+
+		if matchType == parser.MatchEquals {
+			typ = t
+		}
+	}
+
+	return typ, nil
+}
+
+// actionsIn returns the action with the specified UID and all the actions that are, directly or indirectly, members of it.
+func (s *Schema) actionsIn(uid string) []*schemaAction {
+	var actions []*schemaAction
+
+	for _, k := range slices.Sorted(maps.Keys(s.actions)) {
+		if s.actionIn(k, uid, map[string]bool{}) {
+			actions = append(actions, s.actions[k])
+		}
+	}
+
+	return actions
+}
+
+func (s *Schema) actionIn(uid, ancestor string, seen map[string]bool) bool {
+	if uid == ancestor {
+		return true
+	}
+
+	if seen[uid] {
+		return false
+	}
+	seen[uid] = true
+
+	a, ok := s.actions[uid]
+	if !ok {
+		return false
+	}
+
+	return slices.ContainsFunc(a.memberOf, func(m string) bool {
+		return s.actionIn(m, ancestor, seen)
+	})
+}
+
+// resolveEntityType resolves an entity type name used in a namespace to its fully qualified name.
+// An unqualified name refers to a type in the same namespace if declared, otherwise to a type in the empty namespace.
+func (s *Schema) resolveEntityType(namespace, name string) (string, error) {
+	if strings.Contains(name, "::") {
+		if s.hasEntityType(name) {
+			return name, nil
+		}
+	} else {
+		if namespace != "" {
+			if typ := qualify(namespace, name); s.hasEntityType(typ) {
+				return typ, nil
+			}
+		}
+
+		if s.hasEntityType(name) {
+			return name, nil
+		}
+	}
+
+	return name, fmt.Errorf("undeclared entity type %s", name)
+}
+
+func (s *Schema) hasEntityType(typ string) bool {
+	_, ok := s.entityTypes[typ]
+	return ok
+}
+
+func appliesTo(types []string, typ string) bool {
+	if typ == "" {
+		return len(types) > 0
+	}
+
+	return slices.Contains(types, typ)
+}
+
+func describeScope(principalType, resourceType string) string {
+	if principalType == "" {
+		principalType = "any"
+	}
+
+	if resourceType == "" {
+		resourceType = "any"
+	}
+
+	return fmt.Sprintf("principal type %s and resource type %s", principalType, resourceType)
+}
+
+func qualify(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "::" + name
+}
+
+func entityUID(typ, id string) string {
+	return fmt.Sprintf("%s::%q", typ, id)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSchema = `{
+  "PhotoApp": {
+    "entityTypes": {
+      "User": {
+        "memberOfTypes": ["UserGroup"],
+        "shape": {"type": "Record", "attributes": {"department": {"type": "String"}}}
+      },
+      "UserGroup": {},
+      "Photo": {
+        "memberOfTypes": ["Album"]
+      },
+      "Album": {}
+    },
+    "actions": {
+      "viewPhoto": {
+        "memberOf": [{"id": "readOnly"}],
+        "appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Photo"]}
+      },
+      "listAlbums": {
+        "memberOf": [{"id": "readOnly"}],
+        "appliesTo": {"principalTypes": ["User", "UserGroup"], "resourceTypes": ["Album"]}
+      },
+      "readOnly": {}
+    }
+  }
+}`
+
+func TestParseSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema  string
+		wantErr string
+	}{
+		"valid": {
+			schema: testSchema,
+		},
+		"empty namespace": {
+			schema: `{"": {"entityTypes": {"User": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["User"]}}}}}`,
+		},
+		"qualified action group": {
+			schema: `{"A": {"entityTypes": {}, "actions": {"read": {}}}, "B": {"entityTypes": {}, "actions": {"view": {"memberOf": [{"id": "read", "type": "A::Action"}]}}}}`,
+		},
+		"invalid JSON": {
+			schema:  `{`,
+			wantErr: "parsing Cedar schema",
+		},
+		"undeclared memberOfTypes": {
+			schema:  `{"NS": {"entityTypes": {"User": {"memberOfTypes": ["Group"]}}, "actions": {}}}`,
+			wantErr: "entity type NS::User: memberOfTypes: undeclared entity type Group",
+		},
+		"undeclared principal type": {
+			schema:  `{"NS": {"entityTypes": {"Doc": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Doc"]}}}}}`,
+			wantErr: `action NS::Action::"view": principalTypes: undeclared entity type User`,
+		},
+		"undeclared action group": {
+			schema:  `{"NS": {"entityTypes": {}, "actions": {"view": {"memberOf": [{"id": "read"}]}}}}`,
+			wantErr: `action NS::Action::"view": memberOf: undeclared action NS::Action::"read"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSchema(testCase.schema)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("err = %v, want error containing %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaValidatePolicies(t *testing.T) {
+	t.Parallel()
+
+	schema, err := ParseSchema(testSchema)
+	if err != nil {
+		t.Fatalf("parsing schema: %s", err)
+	}
+
+	testCases := map[string]struct {
+		policies string
+		template bool
+		wantErr  string
+	}{
+		"unconstrained": {
+			policies: `permit (principal, action, resource);`,
+		},
+		"equals": {
+			policies: `permit (principal == PhotoApp::User::"alice", action == PhotoApp::Action::"viewPhoto", resource == PhotoApp::Photo::"beach.jpg");`,
+		},
+		"action group": {
+			policies: `permit (principal is PhotoApp::UserGroup, action in PhotoApp::Action::"readOnly", resource);`,
+		},
+		"action list": {
+			policies: `permit (principal, action in [PhotoApp::Action::"viewPhoto", PhotoApp::Action::"listAlbums"], resource in PhotoApp::Album::"vacation");`,
+		},
+		"template": {
+			policies: `permit (principal == ?principal, action == PhotoApp::Action::"viewPhoto", resource in ?resource);`,
+			template: true,
+		},
+		"undeclared principal type": {
+			policies: `permit (principal == PhotoApp::Admin::"bob", action, resource);`,
+			wantErr:  "policy at line 1, column 1: principal: undeclared entity type PhotoApp::Admin",
+		},
+		"undeclared action": {
+			policies: `permit (principal, action == PhotoApp::Action::"deletePhoto", resource);`,
+			wantErr:  `undeclared action PhotoApp::Action::"deletePhoto"`,
+		},
+		"undeclared resource type": {
+			policies: `permit (principal, action, resource is PhotoApp::Video);`,
+			wantErr:  "resource: undeclared entity type PhotoApp::Video",
+		},
+		"inapplicable principal type": {
+			policies: "permit (principal, action, resource);\npermit (principal is PhotoApp::UserGroup, action == PhotoApp::Action::\"viewPhoto\", resource);",
+			wantErr:  "policy at line 2, column 1: no action in the policy scope applies to principal type PhotoApp::UserGroup and resource type any",
+		},
+		"inapplicable resource type": {
+			policies: `permit (principal, action == PhotoApp::Action::"listAlbums", resource == PhotoApp::Photo::"beach.jpg");`,
+			wantErr:  "no action in the policy scope applies to principal type any and resource type PhotoApp::Photo",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parse := ParsePolicies
+			if testCase.template {
+				parse = ParsePolicyTemplate
+			}
+
+			policies, err := parse(testCase.policies)
+			if err != nil {
+				t.Fatalf("parsing policies: %s", err)
+			}
+
+			err = schema.ValidatePolicies(policies)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("err = %v, want error containing %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateRequest(t *testing.T) {
+	t.Parallel()
+
+	schema, err := ParseSchema(testSchema)
+	if err != nil {
+		t.Fatalf("parsing schema: %s", err)
+	}
+
+	testCases := map[string]struct {
+		principalType string
+		action        string
+		resourceType  string
+		wantErr       string
+	}{
+		"valid": {
+			principalType: "PhotoApp::User",
+			action:        `PhotoApp::Action::"viewPhoto"`,
+			resourceType:  "PhotoApp::Photo",
+		},
+		"undeclared action": {
+			principalType: "PhotoApp::User",
+			action:        `PhotoApp::Action::"deletePhoto"`,
+			resourceType:  "PhotoApp::Photo",
+			wantErr:       `undeclared action PhotoApp::Action::"deletePhoto"`,
+		},
+		"undeclared principal type": {
+			principalType: "User",
+			action:        `PhotoApp::Action::"viewPhoto"`,
+			resourceType:  "PhotoApp::Photo",
+			wantErr:       "principal: undeclared entity type User",
+		},
+		"inapplicable": {
+			principalType: "PhotoApp::UserGroup",
+			action:        `PhotoApp::Action::"viewPhoto"`,
+			resourceType:  "PhotoApp::Photo",
+			wantErr:       `action PhotoApp::Action::"viewPhoto" does not apply to principal type PhotoApp::UserGroup and resource type PhotoApp::Photo`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := schema.ValidateRequest(testCase.principalType, testCase.action, testCase.resourceType)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("err = %v, want error containing %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
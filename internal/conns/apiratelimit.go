@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RateLimit is a provider-configured client-side rate limit for a service's API requests.
+// A RateLimit's token bucket is shared by all of the provider's API clients for the service.
+type RateLimit struct {
+	Operation         string // Empty for all operations.
+	RequestsPerSecond float64
+	Burst             int
+
+	once   sync.Once
+	bucket *tokenBucket
+}
+
+func (r *RateLimit) appliesTo(operation string) bool {
+	return r.Operation == "" || r.Operation == operation
+}
+
+// Wait blocks until a request is allowed by the rate limit or the context is done.
+func (r *RateLimit) Wait(ctx context.Context) error {
+	r.once.Do(func() {
+		r.bucket = newTokenBucket(r.RequestsPerSecond, max(r.Burst, 1))
+	})
+
+	return r.bucket.wait(ctx)
+}
+
+// addRateLimitMiddleware returns an API option that adds client-side rate limiting to each attempt of an API request.
+func addRateLimitMiddleware(rateLimits []*RateLimit) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		m := rateLimitMiddleware(rateLimits)
+		if _, ok := stack.Finalize.Get((&retry.Attempt{}).ID()); ok {
+			return stack.Finalize.Insert(m, (&retry.Attempt{}).ID(), middleware.After)
+		}
+		return stack.Finalize.Add(m, middleware.Before)
+	}
+}
+
+func rateLimitMiddleware(rateLimits []*RateLimit) middleware.FinalizeMiddleware {
+	return middleware.FinalizeMiddlewareFunc(
+		"TerraformRateLimit",
+		func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+
+			for _, rateLimit := range rateLimits {
+				if !rateLimit.appliesTo(operation) {
+					continue
+				}
+
+				start := time.Now()
+				if err := rateLimit.Wait(ctx); err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+				}
+				if d := time.Since(start); d >= time.Millisecond {
+					tflog.Trace(ctx, "Rate limited AWS API request", map[string]any{
+						"tf_aws.rate_limit.operation": rateLimit.Operation,
+						"tf_aws.rate_limit.delay":     d.String(),
+					})
+				}
+			}
+
+			return next.HandleFinalize(ctx, in)
+		})
+}
+
+// tokenBucket is a token bucket rate limiter.
+// Requests reserve a token, which may not yet be available, and wait until it is.
+type tokenBucket struct {
+	burst  float64
+	last   time.Time
+	mutex  sync.Mutex
+	rate   float64 // Tokens per second.
+	tokens float64
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		burst:  float64(burst),
+		rate:   rate,
+		tokens: float64(burst),
+	}
+}
+
+// reserve reserves a token and returns the delay until it is available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// release returns a reserved token which was not used.
+func (b *tokenBucket) release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve(time.Now())
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.release()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	b := newTokenBucket(2, 3)
+	now := time.Now()
+
+	// The burst is available immediately.
+	for i := range 3 {
+		if got := b.reserve(now); got != 0 {
+			t.Errorf("reserve %d: got delay %s, want 0", i, got)
+		}
+	}
+
+	// Further requests are spaced at the rate.
+	for i, want := range []time.Duration{500 * time.Millisecond, time.Second, 1500 * time.Millisecond} {
+		if got := b.reserve(now); got != want {
+			t.Errorf("reserve %d: got delay %s, want %s", i, got, want)
+		}
+	}
+
+	// Tokens are replenished at the rate, up to the burst.
+	now = now.Add(10 * time.Second)
+	for i := range 3 {
+		if got := b.reserve(now); got != 0 {
+			t.Errorf("reserve %d after refill: got delay %s, want 0", i, got)
+		}
+	}
+	if got, want := b.reserve(now), 500*time.Millisecond; got != want {
+		t.Errorf("reserve after burst: got delay %s, want %s", got, want)
+	}
+}
+
+func TestRateLimitWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	rateLimit := &RateLimit{
+		RequestsPerSecond: 1000,
+	}
+
+	for range 10 {
+		if err := rateLimit.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	rateLimit = &RateLimit{
+		RequestsPerSecond: 0.001,
+	}
+	if err := rateLimit.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := rateLimit.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %s", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimitAppliesTo(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		rateLimit *RateLimit
+		operation string
+		expected  bool
+	}{
+		{
+			name:      "all operations",
+			rateLimit: &RateLimit{},
+			operation: "ChangeResourceRecordSets",
+			expected:  true,
+		},
+		{
+			name:      "matching operation",
+			rateLimit: &RateLimit{Operation: "ChangeResourceRecordSets"},
+			operation: "ChangeResourceRecordSets",
+			expected:  true,
+		},
+		{
+			name:      "other operation",
+			rateLimit: &RateLimit{Operation: "ChangeResourceRecordSets"},
+			operation: "ListResourceRecordSets",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.rateLimit.appliesTo(testCase.operation), testCase.expected; got != want {
+				t.Errorf("appliesTo(%q) = %t, want %t", testCase.operation, got, want)
+			}
+		})
+	}
+}
@@ -4,8 +4,14 @@
 package conns
 
 import (
+	"regexp"
+	"slices"
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 // AddIsErrorRetryables returns a Retryer which runs the specified retryables on any error.
@@ -27,3 +33,58 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// RetryRule is a provider-configured set of additional retryable errors and retry settings for a service's API client.
+type RetryRule struct {
+	ErrorCodes          []string
+	ErrorMessageRegexes []*regexp.Regexp
+	MaxAttempts         int
+	MaxBackoff          time.Duration
+}
+
+// IsErrorRetryable returns whether the error is an AWS API error with one of the rule's error codes
+// or with an error message matching one of the rule's regular expressions.
+func (r *RetryRule) IsErrorRetryable(err error) aws.Ternary {
+	apiErr, ok := errs.As[smithy.APIError](err)
+	if !ok {
+		return aws.UnknownTernary
+	}
+
+	if slices.Contains(r.ErrorCodes, apiErr.ErrorCode()) {
+		return aws.TrueTernary
+	}
+
+	if slices.ContainsFunc(r.ErrorMessageRegexes, func(re *regexp.Regexp) bool {
+		return re.MatchString(apiErr.ErrorMessage())
+	}) {
+		return aws.TrueTernary
+	}
+
+	return aws.UnknownTernary // Delegate to configured Retryer.
+}
+
+// Retryer returns a Retryer which applies the rule's retryable errors and maximum backoff on top of the specified Retryer.
+// The rule's maximum attempts are applied via the API client's configuration, see AWSClient.apiClientConfig.
+func (r *RetryRule) Retryer(retryer aws.RetryerV2) aws.RetryerV2 {
+	if len(r.ErrorCodes) > 0 || len(r.ErrorMessageRegexes) > 0 {
+		retryer = AddIsErrorRetryables(retryer, r)
+	}
+
+	if r.MaxBackoff > 0 {
+		retryer = &withBackoff{
+			RetryerV2: retryer,
+			backoff:   &v1CompatibleBackoff{maxRetryDelay: r.MaxBackoff},
+		}
+	}
+
+	return retryer
+}
+
+type withBackoff struct {
+	aws.RetryerV2
+	backoff retry.BackoffDelayer
+}
+
+func (r *withBackoff) RetryDelay(attempt int, err error) (time.Duration, error) {
+	return r.backoff.BackoffDelay(attempt, err)
+}
@@ -6,8 +6,11 @@ package conns
 import (
 	"errors"
 	"net/http"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
@@ -93,3 +96,91 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryRule(t *testing.T) {
+	t.Parallel()
+
+	rule := &RetryRule{
+		ErrorCodes:          []string{"ConcurrentModificationException"},
+		ErrorMessageRegexes: []*regexp.Regexp{regexache.MustCompile(`role .* cannot be assumed`)},
+	}
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name: "not an API error",
+			err:  errors.New(`role cannot be assumed`),
+		},
+		{
+			name: "non-matching API error",
+			err:  errs.APIError("InvalidParameterValue", "invalid value"),
+		},
+		{
+			name:     "matching error code",
+			err:      errs.APIError("ConcurrentModificationException", "try again"),
+			expected: true,
+		},
+		{
+			name:     "matching error message",
+			err:      errs.APIError("InvalidParameterValue", "The role arn:aws:iam::123456789012:role/test cannot be assumed"),
+			expected: true,
+		},
+		{
+			name: "wrapped matching error code",
+			err: &smithy.OperationError{
+				ServiceID:     "IAM",
+				OperationName: "CreateRole",
+				Err:           errs.APIError("ConcurrentModificationException", "try again"),
+			},
+			expected: true,
+		},
+		{
+			name:     "default retryable",
+			err:      errs.APIError("ThrottlingException", "Rate exceeded"),
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := rule.Retryer(retry.NewStandard()).IsErrorRetryable(testCase.err)
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("IsErrorRetryable(%q) = %v, want %v", testCase.err, got, want)
+			}
+		})
+	}
+}
+
+func TestRetryRuleRetryer(t *testing.T) {
+	t.Parallel()
+
+	retryer := (&RetryRule{}).Retryer(retry.NewStandard())
+	if got, want := retryer.MaxAttempts(), retry.DefaultMaxAttempts; got != want {
+		t.Errorf("MaxAttempts() = %d, want %d", got, want)
+	}
+
+	retryer = (&RetryRule{
+		MaxAttempts: 10,
+		MaxBackoff:  5 * time.Second,
+	}).Retryer(retry.NewStandard())
+	// Maximum attempts are applied via the API client's configuration.
+	if got, want := retryer.MaxAttempts(), retry.DefaultMaxAttempts; got != want {
+		t.Errorf("MaxAttempts() = %d, want %d", got, want)
+	}
+	for attempt := range 20 {
+		delay, err := retryer.RetryDelay(attempt, errors.New("testing"))
+		if err != nil {
+			t.Fatalf("RetryDelay(%d): unexpected error: %s", attempt, err)
+		}
+		if delay > 5*time.Second {
+			t.Errorf("RetryDelay(%d) = %s, want at most 5s", attempt, delay)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -31,12 +33,15 @@ type AWSClient struct {
 	awsConfig                 *aws.Config
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
+	defaultTimeoutsConfig     *tftimeouts.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	rateLimits                map[string][]*RateLimit // Service package name -> rate limits.
+	retryRules                map[string]*RetryRule   // Service package name -> retry rule.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -77,6 +82,10 @@ func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
 	return c.defaultTagsConfig
 }
 
+func (c *AWSClient) DefaultTimeoutsConfig(context.Context) *tftimeouts.DefaultConfig {
+	return c.defaultTimeoutsConfig
+}
+
 func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
@@ -327,8 +336,28 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	retryRule, rateLimits := c.retryRules[servicePackageName], c.rateLimits[servicePackageName]
+	if retryRule != nil || len(rateLimits) > 0 {
+		cfg := awsConfig.Copy()
+		if retryRule != nil {
+			retryer := cfg.Retryer
+			cfg.Retryer = func() aws.Retryer {
+				return retryRule.Retryer(retryer().(aws.RetryerV2))
+			}
+			// Each API client wraps its Retryer with the configured maximum attempts, so override them there.
+			if retryRule.MaxAttempts > 0 {
+				cfg.RetryMaxAttempts = retryRule.MaxAttempts
+			}
+		}
+		if len(rateLimits) > 0 {
+			cfg.APIOptions = append(slices.Clone(cfg.APIOptions), addRateLimitMiddleware(rateLimits))
+		}
+		awsConfig = &cfg
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
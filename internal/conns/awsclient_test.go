@@ -7,7 +7,10 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var (
@@ -252,3 +255,73 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 		})
 	}
 }
+
+func TestAWSClientAPIClientConfigRetryMaxAttempts(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	testCases := []struct {
+		Name       string
+		RetryRules map[string]*RetryRule
+		Expected   int
+	}{
+		{
+			Name:     "no retry rule",
+			Expected: 25,
+		},
+		{
+			Name: "retry rule without max attempts",
+			RetryRules: map[string]*RetryRule{
+				names.SQS: {
+					ErrorCodes: []string{"ThrottlingException"},
+				},
+			},
+			Expected: 25,
+		},
+		{
+			Name: "retry rule with max attempts",
+			RetryRules: map[string]*RetryRule{
+				names.SQS: {
+					MaxAttempts: 3,
+				},
+			},
+			Expected: 3,
+		},
+		{
+			Name: "retry rule for another service",
+			RetryRules: map[string]*RetryRule{
+				names.SNS: {
+					MaxAttempts: 3,
+				},
+			},
+			Expected: 25,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			// As configured by aws-sdk-go-base from the provider's max_retries.
+			awsConfig := aws.Config{
+				Region:           endpoints.UsWest2RegionID,
+				RetryMaxAttempts: 25,
+				Retryer: func() aws.Retryer {
+					return retry.NewStandard()
+				},
+			}
+			c := &AWSClient{
+				awsConfig:  &awsConfig,
+				partition:  standardPartition,
+				retryRules: testCase.RetryRules,
+			}
+
+			config := c.apiClientConfig(ctx, names.SQS)
+			client := sqs.NewFromConfig(*(config["aws_sdkv2_config"].(*aws.Config)))
+
+			if got, want := client.Options().Retryer.MaxAttempts(), testCase.Expected; got != want {
+				t.Errorf("MaxAttempts() = %d, want %d", got, want)
+			}
+		})
+	}
+}
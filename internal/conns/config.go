@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -32,6 +33,7 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeoutsConfig          *tftimeouts.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -44,8 +46,10 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     map[string][]*RateLimit
 	Region                         string
 	RetryMode                      aws.RetryMode
+	RetryRules                     map[string]*RetryRule
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
@@ -193,7 +197,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.defaultTimeoutsConfig = c.DefaultTimeoutsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.rateLimits = c.RateLimits
+	client.retryRules = c.RetryRules
 	client.terraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
@@ -100,6 +100,8 @@ func IAMPolicyValue(value string) IAMPolicy {
 	return IAMPolicy{StringValue: basetypes.NewStringValue(value)}
 }
 
+// IAMPolicy is an IAM policy document. Values must be valid JSON.
+// To also lint a policy against the IAM catalog, add the validators.IAMPolicyLint validator to the attribute.
 type IAMPolicy struct {
 	basetypes.StringValue
 }
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// iamPolicyLintValidator warns about IAM policy actions, resources and condition keys that are unknown to the IAM catalog.
+type iamPolicyLintValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator iamPolicyLintValidator) Description(_ context.Context) string {
+	return "value should only use IAM actions, resource types and condition keys that are known to apply"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator iamPolicyLintValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator iamPolicyLintValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	configValue := request.ConfigValue
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	// Invalid policies are reported by other validators.
+	warnings, err := verify.LintPolicy(configValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	for _, warning := range warnings {
+		response.Diagnostics.AddAttributeWarning(
+			request.Path,
+			"IAM Policy Lint",
+			"The provided IAM policy may not have the intended effect: "+warning+".\n\n"+
+				"Path: "+request.Path.String(),
+		)
+	}
+}
+
+// IAMPolicyLint returns a string validator which warns if any configured
+// attribute value:
+//
+//   - Is an IAM policy that uses an action that is unknown, doesn't apply to any of its statement's resources
+//     or is used with an unsupported condition key.
+//
+// IAM doesn't validate action names or condition keys, so the policy is checked against the embedded catalog.
+// Only services in the catalog can be checked; a warning is returned for any other service used.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IAMPolicyLint() validator.String {
+	return iamPolicyLintValidator{}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestIAMPolicyLintValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val           types.String
+		expectWarning bool
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"invalid JSON": {
+			val: types.StringValue("not ok"),
+		},
+		"known action": {
+			val: types.StringValue(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`),
+		},
+		"unknown action": {
+			val:           types.StringValue(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObjects", "Resource": "*"}]}`),
+			expectWarning: true,
+		},
+		"service not in catalog": {
+			val:           types.StringValue(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "ec2:DescribeInstances", "Resource": "*"}]}`),
+			expectWarning: true,
+		},
+		// The validator applies to fwtypes.IAMPolicy attributes.
+		"IAM policy value": {
+			val:           fwtypes.IAMPolicyValue(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObjects", "Resource": "*"}]}`).StringValue,
+			expectWarning: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.IAMPolicyLint().ValidateString(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Errorf("unexpected errors: %v", response.Diagnostics)
+			}
+			if got := response.Diagnostics.WarningsCount() > 0; got != test.expectWarning {
+				t.Errorf("response.Diagnostics.WarningsCount() > 0 = %t, want = %t", got, test.expectWarning)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
 )
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
@@ -38,8 +39,14 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 }
 
 // CreateTimeout returns any configured Create timeout value or the default value.
+// A provider-level default timeout for the resource takes precedence over the resource's default value.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
+	defaultTimeout := w.defaultCreateTimeout
+	if v, ok := tftimeouts.FromContext(ctx); ok && v.Create > 0 {
+		defaultTimeout = v.Create
+	}
+
+	timeout, diags := timeouts.Create(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Create timeout", map[string]any{
@@ -47,15 +54,25 @@ func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultCreateTimeout
+		timeout = defaultTimeout
 	}
 
+	tflog.Debug(ctx, "Effective resource timeout", map[string]any{
+		"tf_aws.timeout.create": timeout.String(),
+	})
+
 	return timeout
 }
 
 // ReadTimeout returns any configured Read timeout value or the default value.
+// A provider-level default timeout for the resource takes precedence over the resource's default value.
 func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Read(ctx, w.defaultReadTimeout)
+	defaultTimeout := w.defaultReadTimeout
+	if v, ok := tftimeouts.FromContext(ctx); ok && v.Read > 0 {
+		defaultTimeout = v.Read
+	}
+
+	timeout, diags := timeouts.Read(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Read timeout", map[string]any{
@@ -63,15 +80,25 @@ func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value)
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultReadTimeout
+		timeout = defaultTimeout
 	}
 
+	tflog.Debug(ctx, "Effective resource timeout", map[string]any{
+		"tf_aws.timeout.read": timeout.String(),
+	})
+
 	return timeout
 }
 
 // UpdateTimeout returns any configured Update timeout value or the default value.
+// A provider-level default timeout for the resource takes precedence over the resource's default value.
 func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Update(ctx, w.defaultUpdateTimeout)
+	defaultTimeout := w.defaultUpdateTimeout
+	if v, ok := tftimeouts.FromContext(ctx); ok && v.Update > 0 {
+		defaultTimeout = v.Update
+	}
+
+	timeout, diags := timeouts.Update(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Update timeout", map[string]any{
@@ -79,15 +106,25 @@ func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultUpdateTimeout
+		timeout = defaultTimeout
 	}
 
+	tflog.Debug(ctx, "Effective resource timeout", map[string]any{
+		"tf_aws.timeout.update": timeout.String(),
+	})
+
 	return timeout
 }
 
 // DeleteTimeout returns any configured Delete timeout value or the default value.
+// A provider-level default timeout for the resource takes precedence over the resource's default value.
 func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Delete(ctx, w.defaultDeleteTimeout)
+	defaultTimeout := w.defaultDeleteTimeout
+	if v, ok := tftimeouts.FromContext(ctx); ok && v.Delete > 0 {
+		defaultTimeout = v.Delete
+	}
+
+	timeout, diags := timeouts.Delete(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Delete timeout", map[string]any{
@@ -95,8 +132,12 @@ func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultDeleteTimeout
+		timeout = defaultTimeout
 	}
 
+	tflog.Debug(ctx, "Effective resource timeout", map[string]any{
+		"tf_aws.timeout.delete": timeout.String(),
+	})
+
 	return timeout
 }
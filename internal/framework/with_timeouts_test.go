@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
+)
+
+func TestWithTimeouts(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"delete": types.StringType,
+	}
+	unset := timeouts.Value{
+		Object: types.ObjectNull(attrTypes),
+	}
+	configured := timeouts.Value{
+		Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"create": types.StringValue("5m"),
+			"delete": types.StringNull(),
+		}),
+	}
+
+	var w framework.WithTimeouts
+	w.SetDefaultCreateTimeout(30 * time.Minute)
+	w.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	ctx := t.Context()
+
+	if got, want := w.CreateTimeout(ctx, unset), 30*time.Minute; got != want {
+		t.Errorf("CreateTimeout() = %s, want %s", got, want)
+	}
+
+	ctx = tftimeouts.NewContext(ctx, tftimeouts.Timeouts{
+		Create: 90 * time.Minute,
+	})
+
+	// The provider-level default takes precedence over the resource's default...
+	if got, want := w.CreateTimeout(ctx, unset), 90*time.Minute; got != want {
+		t.Errorf("CreateTimeout() = %s, want %s", got, want)
+	}
+	if got, want := w.DeleteTimeout(ctx, unset), 30*time.Minute; got != want {
+		t.Errorf("DeleteTimeout() = %s, want %s", got, want)
+	}
+
+	// ...and a configured timeout takes precedence over both.
+	if got, want := w.CreateTimeout(ctx, configured), 5*time.Minute; got != want {
+		t.Errorf("CreateTimeout() = %s, want %s", got, want)
+	}
+	if got, want := w.DeleteTimeout(ctx, configured), 30*time.Minute; got != want {
+		t.Errorf("DeleteTimeout() = %s, want %s", got, want)
+	}
+}
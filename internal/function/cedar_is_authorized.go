@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfcedar "github.com/hashicorp/terraform-provider-aws/internal/cedar"
+)
+
+var _ function.Function = cedarIsAuthorizedFunction{}
+
+func NewCedarIsAuthorizedFunction() function.Function {
+	return &cedarIsAuthorizedFunction{}
+}
+
+type cedarIsAuthorizedFunction struct{}
+
+func (f cedarIsAuthorizedFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cedar_is_authorized"
+}
+
+func (f cedarIsAuthorizedFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cedar_is_authorized Function",
+		MarkdownDescription: "Evaluates an authorization request against a set of Cedar policies, " +
+			"optionally validating the policies and request against a Cedar schema. " +
+			"Returns `true` if the request is allowed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policies",
+				MarkdownDescription: "Cedar policies",
+			},
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "Cedar schema in JSON format, or `null` to skip schema validation",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "request",
+				MarkdownDescription: "Authorization request in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cedarIsAuthorizedFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies, request string
+	var schema types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies, &schema, &request))
+	if resp.Error != nil {
+		return
+	}
+
+	var s *tfcedar.Schema
+	if !schema.IsNull() {
+		var err error
+		s, err = tfcedar.ParseSchema(schema.ValueString())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+	}
+
+	r, err := tfcedar.ParseRequest(request)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	result, err := tfcedar.IsAuthorized(policies, s, r)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const (
+	testCedarIsAuthorizedPolicies = `permit (principal == PhotoApp::User::"alice", action == PhotoApp::Action::"viewPhoto", resource in PhotoApp::Album::"vacation");`
+	testCedarIsAuthorizedSchema   = `{"PhotoApp": {"entityTypes": {"User": {}, "Photo": {"memberOfTypes": ["Album"]}, "Album": {}}, "actions": {"viewPhoto": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Photo"]}}}}}`
+	testCedarIsAuthorizedEntities = `[{"uid": {"type": "PhotoApp::Photo", "id": "beach.jpg"}, "parents": [{"type": "PhotoApp::Album", "id": "vacation"}], "attrs": {}}]`
+)
+
+func TestCedarIsAuthorizedFunction_allowed(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCedarIsAuthorizedFunctionConfig(testCedarIsAuthorizedPolicies, "null", "alice"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestCedarIsAuthorizedFunction_denied(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCedarIsAuthorizedFunctionConfig(testCedarIsAuthorizedPolicies, "null", "bob"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestCedarIsAuthorizedFunction_schema(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCedarIsAuthorizedFunctionConfig(testCedarIsAuthorizedPolicies, fmt.Sprintf("%q", testCedarIsAuthorizedSchema), "alice"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestCedarIsAuthorizedFunction_schemaMismatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCedarIsAuthorizedFunctionConfig(`permit (principal, action == PhotoApp::Action::"deletePhoto", resource);`, fmt.Sprintf("%q", testCedarIsAuthorizedSchema), "alice"),
+				ExpectError: regexache.MustCompile(`undeclared[\s\n]*action`),
+			},
+		},
+	})
+}
+
+func TestCedarIsAuthorizedFunction_invalidPolicies(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCedarIsAuthorizedFunctionConfig(`permit (principal, action, resource)`, "null", "alice"),
+				ExpectError: regexache.MustCompile(`parsing[\s\n]*Cedar[\s\n]*policy`),
+			},
+		},
+	})
+}
+
+func testCedarIsAuthorizedFunctionConfig(policies, schema, principalID string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cedar_is_authorized(%[1]q, %[2]s, jsonencode({
+    principal = { type = "PhotoApp::User", id = %[3]q }
+    action    = { type = "PhotoApp::Action", id = "viewPhoto" }
+    resource  = { type = "PhotoApp::Photo", id = "beach.jpg" }
+    context   = {}
+    entities  = jsondecode(%[4]q)
+  }))
+}`, policies, schema, principalID, testCedarIsAuthorizedEntities)
+}
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Prefix lengths supported by VPCs and subnets.
+const (
+	subnetPlanIPv4MinPrefixLength    = 16
+	subnetPlanIPv4MaxPrefixLength    = 28
+	subnetPlanVPCIPv6MinPrefixLength = 44
+	subnetPlanVPCIPv6MaxPrefixLength = 60
+	subnetPlanIPv6MinPrefixLength    = 44
+	subnetPlanIPv6MaxPrefixLength    = 64
+)
+
+var subnetPlanSubnetAttrTypes = map[string]attr.Type{
+	"availability_zone": types.StringType,
+	"cidr_block":        types.StringType,
+	"ipv6_cidr_block":   types.StringType,
+	"tier":              types.StringType,
+}
+
+var subnetPlanResultAttrTypes = map[string]attr.Type{
+	"ipv4_free_address_count": types.Int64Type,
+	"ipv4_free_cidr_blocks":   types.ListType{ElemType: types.StringType},
+	"ipv6_free_cidr_blocks":   types.ListType{ElemType: types.StringType},
+	"subnets":                 types.MapType{ElemType: types.ObjectType{AttrTypes: subnetPlanSubnetAttrTypes}},
+}
+
+var _ function.Function = subnetPlanFunction{}
+
+func NewSubnetPlanFunction() function.Function {
+	return &subnetPlanFunction{}
+}
+
+type subnetPlanFunction struct{}
+
+type subnetPlanTier struct {
+	name             string
+	prefixLength     int
+	ipv6PrefixLength int // 0 if no IPv6 subnets are planned
+}
+
+func (f subnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_plan"
+}
+
+func (f subnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "subnet_plan Function",
+		MarkdownDescription: "Allocates non-overlapping subnets for each tier in each Availability Zone from a VPC's CIDR blocks, " +
+			"using best-fit packing in the order tiers are declared. Returns the planned subnets and the remaining free space.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "azs",
+				MarkdownDescription: "Availability Zones in which to plan subnets",
+				ElementType:         types.StringType,
+			},
+			function.DynamicParameter{
+				Name: "tiers",
+				MarkdownDescription: "List of subnet tiers. Each tier is an object with a `name`, an IPv4 `prefix_length` " +
+					"and an optional `ipv6_prefix_length`",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "vpc_ipv6_cidr",
+			MarkdownDescription: "Optional IPv6 CIDR block of the VPC, required if any tier has an `ipv6_prefix_length`",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: subnetPlanResultAttrTypes,
+		},
+	}
+}
+
+func (f subnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var azs []string
+	var tiersArg types.Dynamic
+	var vpcIPv6CIDRs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &azs, &tiersArg, &vpcIPv6CIDRs))
+	if resp.Error != nil {
+		return
+	}
+
+	ipv4, err := newSubnetPlanAllocator(vpcCIDR, false, subnetPlanIPv4MinPrefixLength, subnetPlanIPv4MaxPrefixLength)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if len(azs) == 0 {
+		resp.Error = function.NewArgumentFuncError(1, "at least one Availability Zone is required")
+		return
+	}
+	for i, az := range azs {
+		if az == "" || slices.Contains(azs[:i], az) {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Availability Zone %q is empty or duplicated", az))
+			return
+		}
+	}
+
+	tiers, err := expandSubnetPlanTiers(tiersArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	var ipv6 *itypes.CIDRBlockAllocator
+	switch len(vpcIPv6CIDRs) {
+	case 0:
+	case 1:
+		ipv6, err = newSubnetPlanAllocator(vpcIPv6CIDRs[0], true, subnetPlanVPCIPv6MinPrefixLength, subnetPlanVPCIPv6MaxPrefixLength)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(3, err.Error())
+			return
+		}
+	default:
+		resp.Error = function.NewArgumentFuncError(3, "at most one IPv6 CIDR block may be specified")
+		return
+	}
+
+	subnets := make(map[string]attr.Value, len(tiers)*len(azs))
+	for _, tier := range tiers {
+		if tier.ipv6PrefixLength != 0 && ipv6 == nil {
+			resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("tier %q has an ipv6_prefix_length but no VPC IPv6 CIDR block was specified", tier.name))
+			return
+		}
+
+		for _, az := range azs {
+			key := tier.name + "-" + az
+			if _, ok := subnets[key]; ok {
+				resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("tier %q in Availability Zone %q produces duplicate subnet key %q", tier.name, az, key))
+				return
+			}
+
+			cidrBlock, err := ipv4.Allocate(tier.prefixLength)
+			if err != nil {
+				resp.Error = function.NewFuncError(fmt.Sprintf("planning tier %q in Availability Zone %q: %s", tier.name, az, err))
+				return
+			}
+
+			ipv6CIDRBlock := types.StringNull()
+			if tier.ipv6PrefixLength != 0 {
+				v, err := ipv6.Allocate(tier.ipv6PrefixLength)
+				if err != nil {
+					resp.Error = function.NewFuncError(fmt.Sprintf("planning tier %q in Availability Zone %q: %s", tier.name, az, err))
+					return
+				}
+				ipv6CIDRBlock = types.StringValue(v)
+			}
+
+			subnet, d := types.ObjectValue(subnetPlanSubnetAttrTypes, map[string]attr.Value{
+				"availability_zone": types.StringValue(az),
+				"cidr_block":        types.StringValue(cidrBlock),
+				"ipv6_cidr_block":   ipv6CIDRBlock,
+				"tier":              types.StringValue(tier.name),
+			})
+			if d.HasError() {
+				resp.Error = function.FuncErrorFromDiags(ctx, d)
+				return
+			}
+			subnets[key] = subnet
+		}
+	}
+
+	ipv6FreeCIDRBlocks := []string{}
+	if ipv6 != nil {
+		ipv6FreeCIDRBlocks = ipv6.FreeCIDRBlocks()
+	}
+
+	subnetsValue, d := types.MapValue(types.ObjectType{AttrTypes: subnetPlanSubnetAttrTypes}, subnets)
+	if d.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, d)
+		return
+	}
+	ipv4FreeCIDRBlocksValue, d := types.ListValueFrom(ctx, types.StringType, ipv4.FreeCIDRBlocks())
+	if d.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, d)
+		return
+	}
+	ipv6FreeCIDRBlocksValue, d := types.ListValueFrom(ctx, types.StringType, ipv6FreeCIDRBlocks)
+	if d.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, d)
+		return
+	}
+
+	result, d := types.ObjectValue(subnetPlanResultAttrTypes, map[string]attr.Value{
+		"ipv4_free_address_count": types.Int64Value(ipv4.FreeAddressCount().Int64()),
+		"ipv4_free_cidr_blocks":   ipv4FreeCIDRBlocksValue,
+		"ipv6_free_cidr_blocks":   ipv6FreeCIDRBlocksValue,
+		"subnets":                 subnetsValue,
+	})
+	if d.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, d)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func newSubnetPlanAllocator(cidr string, ipv6 bool, minPrefixLength, maxPrefixLength int) (*itypes.CIDRBlockAllocator, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	if prefix.Addr().Is6() != ipv6 {
+		if ipv6 {
+			return nil, fmt.Errorf("%q is not an IPv6 CIDR block", cidr)
+		}
+		return nil, fmt.Errorf("%q is not an IPv4 CIDR block", cidr)
+	}
+
+	if bits := prefix.Bits(); bits < minPrefixLength || bits > maxPrefixLength {
+		return nil, fmt.Errorf("%q prefix length must be between /%d and /%d", cidr, minPrefixLength, maxPrefixLength)
+	}
+
+	return itypes.NewCIDRBlockAllocator(cidr)
+}
+
+func expandSubnetPlanTiers(v types.Dynamic) ([]subnetPlanTier, error) {
+	var elements []attr.Value
+	switch v := v.UnderlyingValue().(type) {
+	case types.List:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("tiers must be a list of objects")
+	}
+
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("at least one tier is required")
+	}
+
+	tiers := make([]subnetPlanTier, 0, len(elements))
+	for i, element := range elements {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() {
+			return nil, fmt.Errorf("tier %d must be an object", i)
+		}
+
+		var tier subnetPlanTier
+		attributes := object.Attributes()
+		for _, name := range slices.Sorted(maps.Keys(attributes)) {
+			value := attributes[name]
+
+			var err error
+
+			switch name {
+			case "name":
+				v, ok := value.(types.String)
+				if !ok || v.ValueString() == "" {
+					return nil, fmt.Errorf("tier %d name must be a non-empty string", i)
+				}
+				tier.name = v.ValueString()
+			case "prefix_length":
+				tier.prefixLength, err = subnetPlanPrefixLength(value, subnetPlanIPv4MinPrefixLength, subnetPlanIPv4MaxPrefixLength, 1)
+			case "ipv6_prefix_length":
+				if !value.IsNull() {
+					tier.ipv6PrefixLength, err = subnetPlanPrefixLength(value, subnetPlanIPv6MinPrefixLength, subnetPlanIPv6MaxPrefixLength, 4)
+				}
+			default:
+				return nil, fmt.Errorf("tier %d has unsupported attribute %q", i, name)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("tier %d %s %w", i, name, err)
+			}
+		}
+
+		if tier.name == "" {
+			return nil, fmt.Errorf("tier %d name is required", i)
+		}
+		if tier.prefixLength == 0 {
+			return nil, fmt.Errorf("tier %q prefix_length is required", tier.name)
+		}
+		if slices.ContainsFunc(tiers, func(v subnetPlanTier) bool { return v.name == tier.name }) {
+			return nil, fmt.Errorf("tier %q is declared more than once", tier.name)
+		}
+
+		tiers = append(tiers, tier)
+	}
+
+	return tiers, nil
+}
+
+func subnetPlanPrefixLength(value attr.Value, minPrefixLength, maxPrefixLength, step int) (int, error) {
+	var f *big.Float
+	switch v := value.(type) {
+	case types.Number:
+		f = v.ValueBigFloat()
+	case types.Int64:
+		f = new(big.Float).SetInt64(v.ValueInt64())
+	}
+
+	if f == nil || !f.IsInt() {
+		return 0, fmt.Errorf("must be a whole number")
+	}
+
+	n, _ := f.Int64()
+	if n < int64(minPrefixLength) || n > int64(maxPrefixLength) || n%int64(step) != 0 {
+		if step > 1 {
+			return 0, fmt.Errorf("must be a multiple of %d between %d and %d, got %d", step, minPrefixLength, maxPrefixLength, n)
+		}
+		return 0, fmt.Errorf("must be between %d and %d, got %d", minPrefixLength, maxPrefixLength, n)
+	}
+
+	return int(n), nil
+}
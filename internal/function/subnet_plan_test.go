@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestSubnetPlanFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetPlanFunctionConfig("10.0.0.0/16", `
+  { name = "public", prefix_length = 24 },
+  { name = "private", prefix_length = 20 },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_a", "10.0.0.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.1.0/24"),
+					resource.TestCheckOutput("private_a", "10.0.16.0/20"),
+					resource.TestCheckOutput("private_b", "10.0.32.0/20"),
+					resource.TestCheckOutput("free_address_count", "56832"),
+					resource.TestCheckOutput("free_cidr_blocks", "10.0.2.0/23,10.0.4.0/22,10.0.8.0/21,10.0.48.0/20,10.0.64.0/18,10.0.128.0/17"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetPlanFunction_appendTier(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetPlanFunctionConfig("10.0.0.0/16", `
+  { name = "public", prefix_length = 24 },
+  { name = "private", prefix_length = 20 },
+  { name = "database", prefix_length = 24 },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_a", "10.0.0.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.1.0/24"),
+					resource.TestCheckOutput("private_a", "10.0.16.0/20"),
+					resource.TestCheckOutput("private_b", "10.0.32.0/20"),
+					resource.TestCheckOutput("free_address_count", "56320"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetPlanFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  plan = provider::aws::subnet_plan("10.0.0.0/16", ["us-west-2a", "us-west-2b"], [
+    { name = "public", prefix_length = 24, ipv6_prefix_length = 64 },
+    { name = "private", prefix_length = 24 },
+  ], "2600:1f14:abc:de00::/56")
+}
+
+output "public_a" {
+  value = local.plan.subnets["public-us-west-2a"].ipv6_cidr_block
+}
+
+output "public_b" {
+  value = local.plan.subnets["public-us-west-2b"].ipv6_cidr_block
+}
+
+output "private_a" {
+  value = local.plan.subnets["private-us-west-2a"].ipv6_cidr_block == null
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_a", "2600:1f14:abc:de00::/64"),
+					resource.TestCheckOutput("public_b", "2600:1f14:abc:de01::/64"),
+					resource.TestCheckOutput("private_a", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetPlanFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetPlanFunctionConfig("10.0.0.0/20", `
+  { name = "public", prefix_length = 21 },
+  { name = "private", prefix_length = 24 },
+`),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*free[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func TestSubnetPlanFunction_invalidTier(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetPlanFunctionConfig("10.0.0.0/16", `
+  { name = "public", prefix_length = 30 },
+`),
+				ExpectError: regexache.MustCompile(`prefix_length[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*16[\s\n]*and[\s\n]*28`),
+			},
+		},
+	})
+}
+
+func testSubnetPlanFunctionConfig(vpcCIDR, tiers string) string {
+	return fmt.Sprintf(`
+locals {
+  plan = provider::aws::subnet_plan(%[1]q, ["us-west-2a", "us-west-2b"], [%[2]s])
+}
+
+output "public_a" {
+  value = local.plan.subnets["public-us-west-2a"].cidr_block
+}
+
+output "public_b" {
+  value = local.plan.subnets["public-us-west-2b"].cidr_block
+}
+
+output "private_a" {
+  value = local.plan.subnets["private-us-west-2a"].cidr_block
+}
+
+output "private_b" {
+  value = local.plan.subnets["private-us-west-2b"].cidr_block
+}
+
+output "free_address_count" {
+  value = local.plan.ipv4_free_address_count
+}
+
+output "free_cidr_blocks" {
+  value = join(",", local.plan.ipv4_free_cidr_blocks)
+}
+`, vpcCIDR, tiers)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+// The AWS Service Authorization Reference in machine-readable form.
+// See https://docs.aws.amazon.com/service-authorization/latest/reference/service-reference.html.
+const serviceReferenceURL = "https://servicereference.us-east-1.amazonaws.com/"
+
+// The IAM service prefixes of the services included in the catalog.
+// The full Service Authorization Reference is too large to embed in the provider, so only the services whose policies are most
+// commonly written with the provider's policy resources are included. verify.LintPolicy reports actions and condition keys of
+// other services as not covered. Add a service prefix here and run `go generate ./names/data` to extend the catalog.
+var services = []string{
+	"kms",
+	"s3",
+	"sns",
+	"sqs",
+	"sts",
+}
+
+type serviceReferenceIndexEntry struct {
+	Service string `json:"service"`
+	URL     string `json:"url"`
+}
+
+type serviceReference struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name      string `json:"Name"`
+		Resources []struct {
+			Name string `json:"Name"`
+		} `json:"Resources"`
+	} `json:"Actions"`
+	ConditionKeys []struct {
+		Name string `json:"Name"`
+	} `json:"ConditionKeys"`
+	Resources []struct {
+		Name       string   `json:"Name"`
+		ARNFormats []string `json:"ARNFormats"`
+	} `json:"Resources"`
+}
+
+// Mirrors names/data.ServiceAuthorization.
+type serviceAuthorization struct {
+	Actions       map[string]serviceAuthorizationAction `json:"actions"`
+	ConditionKeys []string                              `json:"condition_keys"`
+	ResourceTypes map[string][]string                   `json:"resource_types"`
+}
+
+type serviceAuthorizationAction struct {
+	ResourceTypes []string `json:"resource_types,omitempty"`
+}
+
+func main() {
+	const (
+		filename = `service_authorization.json`
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating names/data/%s", filename)
+
+	ctx := context.Background()
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	var index []serviceReferenceIndexEntry
+	if err := getJSON(ctx, client, serviceReferenceURL, &index); err != nil {
+		g.Fatalf("reading service reference index: %s", err)
+	}
+
+	catalog := make(map[string]serviceAuthorization, len(services))
+	for _, entry := range index {
+		if !slices.Contains(services, strings.ToLower(entry.Service)) {
+			continue
+		}
+
+		var ref serviceReference
+		if err := getJSON(ctx, client, entry.URL, &ref); err != nil {
+			g.Fatalf("reading service reference (%s): %s", entry.Service, err)
+		}
+
+		sa := serviceAuthorization{
+			Actions:       make(map[string]serviceAuthorizationAction, len(ref.Actions)),
+			ConditionKeys: []string{},
+			ResourceTypes: make(map[string][]string, len(ref.Resources)),
+		}
+		for _, action := range ref.Actions {
+			var resourceTypes []string
+			for _, resource := range action.Resources {
+				resourceTypes = append(resourceTypes, resource.Name)
+			}
+			slices.Sort(resourceTypes)
+			sa.Actions[action.Name] = serviceAuthorizationAction{
+				ResourceTypes: slices.Compact(resourceTypes),
+			}
+		}
+		for _, key := range ref.ConditionKeys {
+			sa.ConditionKeys = append(sa.ConditionKeys, key.Name)
+		}
+		slices.SortFunc(sa.ConditionKeys, func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+		for _, resource := range ref.Resources {
+			sa.ResourceTypes[resource.Name] = resource.ARNFormats
+		}
+
+		catalog[strings.ToLower(entry.Service)] = sa
+	}
+
+	for _, service := range services {
+		if _, ok := catalog[service]; !ok {
+			g.Fatalf("service %q not found in service reference index", service)
+		}
+	}
+
+	body, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes(append(body, '\n')); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ExtensionPrefix is the prefix of all Amazon API Gateway OpenAPI extensions.
+	ExtensionPrefix = "x-amazon-apigateway-"
+
+	ExtensionAnyMethod   = ExtensionPrefix + "any-method"
+	ExtensionIntegration = ExtensionPrefix + "integration"
+)
+
+// Document is a decoded OpenAPI document.
+// All numbers are float64 values and all object keys are strings, as if the document had been decoded from JSON.
+type Document map[string]any
+
+// Decode decodes an OpenAPI document in JSON or YAML format.
+func Decode(s string) (Document, error) {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	if strings.HasPrefix(strings.TrimSpace(s), "{") {
+		var doc Document
+
+		if err := json.Unmarshal([]byte(s), &doc); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	}
+
+	var node yaml.Node
+
+	if err := yaml.Unmarshal([]byte(s), &node); err != nil {
+		return nil, err
+	}
+
+	v, err := fromYAMLNode(&node)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Round-trip through JSON so that YAML and JSON documents decode to the same Go types.
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var doc Document
+
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, errors.New("OpenAPI document must be an object")
+	}
+
+	return doc, nil
+}
+
+// Normalize returns the canonical JSON representation of an OpenAPI document in JSON or YAML format.
+// Object keys, including `x-amazon-apigateway-*` extensions, are sorted and the elements of
+// extension values that API Gateway treats as unordered sets are sorted.
+// An empty (or whitespace-only) document normalizes to "".
+func Normalize(s string) (string, error) {
+	if strings.TrimSpace(s) == "" {
+		return "", nil
+	}
+
+	doc, err := Decode(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return doc.normalize().JSON()
+}
+
+// JSON returns the document's indented JSON representation with sorted object keys.
+func (doc Document) JSON() (string, error) {
+	b, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (doc Document) normalize() Document {
+	sortExtensionSets(map[string]any(doc))
+
+	return doc
+}
+
+// unorderedExtensionLists maps each API Gateway extension to the properties of its value whose elements are unordered.
+// An empty property name means that the extension value itself is an unordered list.
+var unorderedExtensionLists = map[string][]string{
+	ExtensionPrefix + "binary-media-types":     {""},
+	ExtensionPrefix + "cors":                   {"allowHeaders", "allowMethods", "allowOrigins", "exposeHeaders"},
+	ExtensionPrefix + "endpoint-configuration": {"vpcEndpointIds"},
+	ExtensionIntegration:                       {"cacheKeyParameters"},
+}
+
+func sortExtensionSets(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if properties, ok := unorderedExtensionLists[k]; ok {
+				for _, property := range properties {
+					if property == "" {
+						sortStrings(e)
+					} else if m, ok := e.(map[string]any); ok {
+						sortStrings(m[property])
+					}
+				}
+			}
+
+			sortExtensionSets(e)
+		}
+	case []any:
+		for _, e := range v {
+			sortExtensionSets(e)
+		}
+	}
+}
+
+// sortStrings sorts v in place if it is a list of strings.
+func sortStrings(v any) {
+	l, ok := v.([]any)
+
+	if !ok {
+		return
+	}
+
+	for _, e := range l {
+		if _, ok := e.(string); !ok {
+			return
+		}
+	}
+
+	slices.SortFunc(l, func(a, b any) int {
+		return strings.Compare(a.(string), b.(string))
+	})
+}
+
+// fromYAMLNode converts a YAML node to plain Go values.
+// Unlike decoding into `any`, mapping keys are always strings (e.g. unquoted response codes)
+// and timestamps are left as strings (e.g. dates used as `info.version`).
+func fromYAMLNode(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+
+		return fromYAMLNode(node.Content[0])
+
+	case yaml.AliasNode:
+		return fromYAMLNode(node.Alias)
+
+	case yaml.MappingNode:
+		m := make(map[string]any, len(node.Content)/2)
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			k, v := node.Content[i], node.Content[i+1]
+
+			value, err := fromYAMLNode(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if k.ShortTag() == "!!merge" {
+				merges := []any{value}
+				if l, ok := value.([]any); ok {
+					merges = l
+				}
+
+				for _, merge := range merges {
+					mm, ok := merge.(map[string]any)
+
+					if !ok {
+						return nil, fmt.Errorf("line %d: merge key value must be a mapping", k.Line)
+					}
+
+					for k, v := range mm {
+						if _, ok := m[k]; !ok {
+							m[k] = v
+						}
+					}
+				}
+
+				continue
+			}
+
+			m[k.Value] = value
+		}
+
+		return m, nil
+
+	case yaml.SequenceNode:
+		l := make([]any, 0, len(node.Content))
+
+		for _, v := range node.Content {
+			value, err := fromYAMLNode(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			l = append(l, value)
+		}
+
+		return l, nil
+
+	case yaml.ScalarNode:
+		if node.ShortTag() == "!!timestamp" {
+			return node.Value, nil
+		}
+
+		var v any
+
+		if err := node.Decode(&v); err != nil {
+			return nil, err
+		}
+
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("line %d: unsupported YAML node", node.Line)
+}
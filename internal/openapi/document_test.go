@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s1, s2     string
+		equivalent bool
+	}{
+		"empty": {
+			s1:         "",
+			s2:         "  \n",
+			equivalent: true,
+		},
+		"JSON key order": {
+			s1:         `{"openapi": "3.0.1", "info": {"title": "example", "version": "1.0"}}`,
+			s2:         `{"info": {"version": "1.0", "title": "example"}, "openapi": "3.0.1"}`,
+			equivalent: true,
+		},
+		"JSON and YAML": {
+			s1: `{"openapi": "3.0.1", "info": {"title": "example", "version": "2020-01-01"}, "paths": {"/": {"get": {"responses": {"200": {"description": "OK"}}}}}}`,
+			s2: `
+openapi: 3.0.1
+info:
+  title: example
+  version: 2020-01-01
+paths:
+  /:
+    get:
+      responses:
+        200:
+          description: OK
+`,
+			equivalent: true,
+		},
+		"CRLF": {
+			s1:         "openapi: 3.0.1\r\ninfo:\r\n  title: example\r\n",
+			s2:         "openapi: 3.0.1\ninfo:\n  title: example\n",
+			equivalent: true,
+		},
+		"YAML anchors": {
+			s1: `
+x-amazon-apigateway-integration: &integration
+  type: aws_proxy
+  httpMethod: POST
+paths:
+  /:
+    get:
+      x-amazon-apigateway-integration: *integration
+`,
+			s2:         `{"x-amazon-apigateway-integration": {"httpMethod": "POST", "type": "aws_proxy"}, "paths": {"/": {"get": {"x-amazon-apigateway-integration": {"httpMethod": "POST", "type": "aws_proxy"}}}}}`,
+			equivalent: true,
+		},
+		"extension order": {
+			s1:         `{"x-amazon-apigateway-binary-media-types": ["image/png", "*/*"], "x-amazon-apigateway-api-key-source": "HEADER"}`,
+			s2:         `{"x-amazon-apigateway-api-key-source": "HEADER", "x-amazon-apigateway-binary-media-types": ["*/*", "image/png"]}`,
+			equivalent: true,
+		},
+		"CORS extension lists": {
+			s1:         `{"x-amazon-apigateway-cors": {"allowOrigins": ["https://b.example.com", "https://a.example.com"], "allowMethods": ["GET", "POST"]}}`,
+			s2:         `{"x-amazon-apigateway-cors": {"allowMethods": ["POST", "GET"], "allowOrigins": ["https://a.example.com", "https://b.example.com"]}}`,
+			equivalent: true,
+		},
+		"ordered lists": {
+			s1:         `{"servers": [{"url": "https://a.example.com"}, {"url": "https://b.example.com"}]}`,
+			s2:         `{"servers": [{"url": "https://b.example.com"}, {"url": "https://a.example.com"}]}`,
+			equivalent: false,
+		},
+		"different values": {
+			s1:         `{"info": {"title": "example", "version": "1.0"}}`,
+			s2:         "info:\n  title: example\n  version: 1.0\n",
+			equivalent: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			n1, err := Normalize(testCase.s1)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			n2, err := Normalize(testCase.s2)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := n1 == n2, testCase.equivalent; got != want {
+				t.Errorf("equivalent = %t, want %t\n%s\n%s", got, want, n1, n2)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		`{"openapi": `,
+		"openapi: [3.0.1\n",
+		"- a\n- b\n",
+	} {
+		if _, err := Decode(s); err == nil {
+			t.Errorf("Decode(%q): expected error", s)
+		}
+	}
+}
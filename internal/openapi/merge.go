@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Merge deep merges src into doc.
+// Objects are merged key by key. Any other value present in both documents is
+// replaced by the value from src if override is true; otherwise the values must be equal.
+func (doc Document) Merge(src Document, override bool) error {
+	return mergeObjects(doc, src, override, nil)
+}
+
+func mergeObjects(dst, src map[string]any, override bool, path []string) error {
+	for _, k := range slices.Sorted(maps.Keys(src)) {
+		v := src[k]
+		path := append(slices.Clone(path), k)
+
+		existing, ok := dst[k]
+
+		if !ok {
+			dst[k] = v
+			continue
+		}
+
+		if dm, ok := existing.(map[string]any); ok {
+			if sm, ok := v.(map[string]any); ok {
+				if err := mergeObjects(dm, sm, override, path); err != nil {
+					return err
+				}
+
+				continue
+			}
+		}
+
+		if override {
+			dst[k] = v
+			continue
+		}
+
+		if !reflect.DeepEqual(existing, v) {
+			return fmt.Errorf("conflicting values for %s", formatPath(path))
+		}
+	}
+
+	return nil
+}
+
+// formatPath formats a path to a value in a document, quoting keys such as URL paths that aren't simple identifiers.
+func formatPath(path []string) string {
+	var sb strings.Builder
+
+	for i, k := range path {
+		if k != "" && !strings.ContainsAny(k, `/.{}[]"' `) {
+			if i > 0 {
+				sb.WriteByte('.')
+			}
+			sb.WriteString(k)
+		} else {
+			fmt.Fprintf(&sb, "[%q]", k)
+		}
+	}
+
+	return sb.String()
+}
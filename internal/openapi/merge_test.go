@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dst, src string
+		override bool
+		want     string
+		wantErr  string
+	}{
+		"disjoint paths": {
+			dst:  `{"paths": {"/a": {"get": {"responses": {"200": {"description": "OK"}}}}}}`,
+			src:  "paths:\n  /b:\n    post:\n      responses:\n        201:\n          description: Created\n",
+			want: `{"paths": {"/a": {"get": {"responses": {"200": {"description": "OK"}}}}, "/b": {"post": {"responses": {"201": {"description": "Created"}}}}}}`,
+		},
+		"same path different methods": {
+			dst:  `{"paths": {"/a": {"get": {"operationId": "getA"}}}}`,
+			src:  `{"paths": {"/a": {"put": {"operationId": "putA"}}}}`,
+			want: `{"paths": {"/a": {"get": {"operationId": "getA"}, "put": {"operationId": "putA"}}}}`,
+		},
+		"equal values": {
+			dst:  `{"openapi": "3.0.1", "servers": [{"url": "https://example.com"}]}`,
+			src:  `{"openapi": "3.0.1", "servers": [{"url": "https://example.com"}]}`,
+			want: `{"openapi": "3.0.1", "servers": [{"url": "https://example.com"}]}`,
+		},
+		"conflict": {
+			dst:     `{"paths": {"/a": {"get": {"operationId": "getA"}}}}`,
+			src:     `{"paths": {"/a": {"get": {"operationId": "fetchA"}}}}`,
+			wantErr: `conflicting values for paths["/a"].get.operationId`,
+		},
+		"override": {
+			dst:      `{"info": {"title": "a", "version": "1"}, "servers": [{"url": "https://a.example.com"}]}`,
+			src:      `{"info": {"title": "b"}, "servers": [{"url": "https://b.example.com"}]}`,
+			override: true,
+			want:     `{"info": {"title": "b", "version": "1"}, "servers": [{"url": "https://b.example.com"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dst, err := Decode(testCase.dst)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			src, err := Decode(testCase.src)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = dst.Merge(src, testCase.override)
+
+			if testCase.wantErr != "" {
+				if err == nil || err.Error() != testCase.wantErr {
+					t.Fatalf("error = %v, want %q", err, testCase.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := dst.JSON()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			want, err := Normalize(testCase.want)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+var (
+	versionRegexp       = regexache.MustCompile(`^3\.0\.\d+$`)
+	responseCodeRegexp  = regexache.MustCompile(`^[1-5](?:\d\d|XX)$`)
+	componentNameRegexp = regexache.MustCompile(`^[0-9A-Za-z._-]+$`)
+
+	// Fixed fields of the OpenAPI 3.0 objects that are validated.
+	rootFields      = []string{"components", "externalDocs", "info", "openapi", "paths", "security", "servers", "tags"}
+	infoFields      = []string{"contact", "description", "license", "termsOfService", "title", "version"}
+	pathItemFields  = []string{"$ref", "delete", "description", "get", "head", "options", "parameters", "patch", "post", "put", "servers", "summary", "trace"}
+	operationFields = []string{"callbacks", "deprecated", "description", "externalDocs", "operationId", "parameters", "requestBody", "responses", "security", "servers", "summary", "tags"}
+	parameterFields = []string{"allowEmptyValue", "allowReserved", "content", "deprecated", "description", "example", "examples", "explode", "in", "name", "required", "schema", "style"}
+	componentFields = []string{"callbacks", "examples", "headers", "links", "parameters", "requestBodies", "responses", "schemas", "securitySchemes"}
+
+	// Methods are the operations of a path item, including the API Gateway catch-all method.
+	methods = []string{"delete", "get", "head", "options", "patch", "post", "put", "trace", ExtensionAnyMethod}
+
+	parameterLocations = []string{"cookie", "header", "path", "query"}
+)
+
+// Validate validates the document against the OpenAPI Specification 3.0.
+// All problems found are returned.
+func (doc Document) Validate() error {
+	v := &validator{doc: doc}
+
+	v.validate()
+
+	return errors.Join(v.errs...)
+}
+
+type validator struct {
+	doc  Document
+	errs []error
+}
+
+func (v *validator) errorf(path []string, format string, a ...any) {
+	msg := fmt.Sprintf(format, a...)
+
+	if len(path) > 0 {
+		msg = formatPath(path) + ": " + msg
+	}
+
+	v.errs = append(v.errs, errors.New(msg))
+}
+
+func (v *validator) validate() {
+	if v.doc == nil {
+		v.errorf(nil, "OpenAPI document must be an object")
+		return
+	}
+
+	root := map[string]any(v.doc)
+	v.fields(nil, root, rootFields)
+
+	if version, ok := v.requiredString(nil, root, "openapi"); ok && !versionRegexp.MatchString(version) {
+		v.errorf([]string{"openapi"}, "unsupported OpenAPI version (%s), must be 3.0.x", version)
+	}
+
+	if info, ok := v.requiredObject(nil, root, "info"); ok {
+		path := []string{"info"}
+		v.fields(path, info, infoFields)
+		v.requiredString(path, info, "title")
+		v.requiredString(path, info, "version")
+	}
+
+	if paths, ok := v.requiredObject(nil, root, "paths"); ok {
+		v.paths(paths)
+	}
+
+	if components, ok := v.optionalObject(nil, root, "components"); ok {
+		v.components(components)
+	}
+
+	v.references(nil, root)
+}
+
+func (v *validator) paths(paths map[string]any) {
+	operationIDs := make(map[string][]string)
+
+	for _, p := range slices.Sorted(maps.Keys(paths)) {
+		path := []string{"paths", p}
+
+		if isExtension(p) {
+			continue
+		}
+
+		if !strings.HasPrefix(p, "/") {
+			v.errorf(path, "path must begin with a forward slash (/)")
+		}
+
+		item, ok := paths[p].(map[string]any)
+
+		if !ok {
+			v.errorf(path, "path item must be an object")
+			continue
+		}
+
+		v.fields(path, item, pathItemFields)
+		v.parameters(path, item)
+
+		for _, method := range methods {
+			path := append(slices.Clone(path), method)
+
+			value, ok := item[method]
+
+			if !ok {
+				continue
+			}
+
+			operation, ok := value.(map[string]any)
+
+			if !ok {
+				v.errorf(path, "operation must be an object")
+				continue
+			}
+
+			v.fields(path, operation, operationFields)
+			v.parameters(path, operation)
+
+			if id, ok := operation["operationId"]; ok {
+				if id, ok := id.(string); ok {
+					operationIDs[id] = append(operationIDs[id], formatPath(path))
+				} else {
+					v.errorf(append(path, "operationId"), "must be a string")
+				}
+			}
+
+			if responses, ok := v.requiredObject(path, operation, "responses"); ok {
+				v.responses(append(path, "responses"), responses)
+			}
+		}
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(operationIDs)) {
+		if paths := operationIDs[id]; len(paths) > 1 {
+			v.errorf(nil, "operationId (%s) is not unique: %s", id, strings.Join(paths, ", "))
+		}
+	}
+}
+
+func (v *validator) parameters(path []string, parent map[string]any) {
+	value, ok := parent["parameters"]
+
+	if !ok {
+		return
+	}
+
+	path = append(slices.Clone(path), "parameters")
+
+	parameters, ok := value.([]any)
+
+	if !ok {
+		v.errorf(path, "must be an array")
+		return
+	}
+
+	seen := make(map[string]bool)
+
+	for i, value := range parameters {
+		path := append(slices.Clone(path), strconv.Itoa(i))
+
+		parameter, ok := value.(map[string]any)
+
+		if !ok {
+			v.errorf(path, "parameter must be an object")
+			continue
+		}
+
+		if _, ok := parameter["$ref"]; ok {
+			continue
+		}
+
+		v.fields(path, parameter, parameterFields)
+
+		name, ok := v.requiredString(path, parameter, "name")
+		in, ok2 := v.requiredString(path, parameter, "in")
+
+		if !ok2 {
+			continue
+		}
+
+		if !slices.Contains(parameterLocations, in) {
+			v.errorf(append(path, "in"), "invalid parameter location (%s), must be one of %s", in, strings.Join(parameterLocations, ", "))
+			continue
+		}
+
+		if in == "path" && parameter["required"] != true {
+			v.errorf(path, "path parameter (%s) must be required", name)
+		}
+
+		if ok {
+			if key := in + ":" + name; seen[key] {
+				v.errorf(path, "duplicate %s parameter (%s)", in, name)
+			} else {
+				seen[key] = true
+			}
+		}
+	}
+}
+
+func (v *validator) responses(path []string, responses map[string]any) {
+	if len(responses) == 0 {
+		v.errorf(path, "must contain at least one response")
+		return
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(responses)) {
+		path := append(slices.Clone(path), code)
+
+		if isExtension(code) {
+			continue
+		}
+
+		if code != "default" && !responseCodeRegexp.MatchString(code) {
+			v.errorf(path, "invalid response code, must be \"default\", an HTTP status code or a range such as 2XX")
+		}
+
+		response, ok := responses[code].(map[string]any)
+
+		if !ok {
+			v.errorf(path, "response must be an object")
+			continue
+		}
+
+		if _, ok := response["$ref"]; ok {
+			continue
+		}
+
+		v.requiredString(path, response, "description")
+	}
+}
+
+func (v *validator) components(components map[string]any) {
+	path := []string{"components"}
+
+	v.fields(path, components, componentFields)
+
+	for _, k := range componentFields {
+		path := append(slices.Clone(path), k)
+
+		c, ok := v.optionalObject(path[:1], components, k)
+
+		if !ok {
+			continue
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(c)) {
+			if !componentNameRegexp.MatchString(name) {
+				v.errorf(path, "invalid component name (%s)", name)
+			}
+		}
+	}
+}
+
+// references checks that all local references (`$ref` values beginning with "#/") resolve.
+func (v *validator) references(path []string, value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(value)) {
+			path := append(slices.Clone(path), k)
+
+			if ref, ok := value[k].(string); ok && k == "$ref" {
+				if strings.HasPrefix(ref, "#") && !v.resolves(ref) {
+					v.errorf(path, "unresolved reference (%s)", ref)
+				}
+				continue
+			}
+
+			v.references(path, value[k])
+		}
+	case []any:
+		for i, e := range value {
+			v.references(append(slices.Clone(path), strconv.Itoa(i)), e)
+		}
+	}
+}
+
+// resolves returns whether a local reference, a JSON Pointer (RFC 6901) in a URI fragment, identifies a value in the document.
+func (v *validator) resolves(ref string) bool {
+	fragment, err := url.PathUnescape(strings.TrimPrefix(ref, "#"))
+
+	if err != nil {
+		return false
+	}
+
+	if fragment == "" {
+		return true
+	}
+
+	if !strings.HasPrefix(fragment, "/") {
+		return false
+	}
+
+	var current any = map[string]any(v.doc)
+
+	for token := range strings.SplitSeq(fragment[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		switch c := current.(type) {
+		case map[string]any:
+			next, ok := c[token]
+
+			if !ok {
+				return false
+			}
+
+			current = next
+		case []any:
+			i, err := strconv.Atoi(token)
+
+			if err != nil || i < 0 || i >= len(c) {
+				return false
+			}
+
+			current = c[i]
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// fields checks that an object has only the specified fixed fields or extensions.
+func (v *validator) fields(path []string, object map[string]any, fields []string) {
+	for _, k := range slices.Sorted(maps.Keys(object)) {
+		if !isExtension(k) && !slices.Contains(fields, k) {
+			v.errorf(append(slices.Clone(path), k), "unsupported field")
+		}
+	}
+}
+
+func (v *validator) requiredString(path []string, object map[string]any, k string) (string, bool) {
+	path = append(slices.Clone(path), k)
+
+	value, ok := object[k]
+
+	if !ok {
+		v.errorf(path, "required field is missing")
+		return "", false
+	}
+
+	s, ok := value.(string)
+
+	if !ok {
+		v.errorf(path, "must be a string")
+		return "", false
+	}
+
+	return s, true
+}
+
+func (v *validator) requiredObject(path []string, object map[string]any, k string) (map[string]any, bool) {
+	if _, ok := object[k]; !ok {
+		v.errorf(append(slices.Clone(path), k), "required field is missing")
+		return nil, false
+	}
+
+	return v.optionalObject(path, object, k)
+}
+
+func (v *validator) optionalObject(path []string, object map[string]any, k string) (map[string]any, bool) {
+	value, ok := object[k]
+
+	if !ok {
+		return nil, false
+	}
+
+	m, ok := value.(map[string]any)
+
+	if !ok {
+		v.errorf(append(slices.Clone(path), k), "must be an object")
+		return nil, false
+	}
+
+	return m, true
+}
+
+func isExtension(k string) bool {
+	return strings.HasPrefix(k, "x-")
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document string
+		wantErrs []string
+	}{
+		"valid": {
+			document: `
+openapi: 3.0.1
+info:
+  title: example
+  version: "1.0"
+paths:
+  /pets/{petId}:
+    parameters:
+      - $ref: "#/components/parameters/petId"
+    get:
+      operationId: getPet
+      responses:
+        200:
+          $ref: "#/components/responses/Pet"
+        4XX:
+          description: Client error
+      x-amazon-apigateway-integration:
+        type: aws_proxy
+        httpMethod: POST
+        uri: arn:aws:apigateway:us-west-2:lambda:path/2015-03-31/functions/arn:aws:lambda:us-west-2:123456789012:function:example/invocations
+    x-amazon-apigateway-any-method:
+      responses:
+        default:
+          description: Default response
+components:
+  parameters:
+    petId:
+      name: petId
+      in: path
+      required: true
+      schema:
+        type: string
+  responses:
+    Pet:
+      description: A pet
+x-amazon-apigateway-binary-media-types:
+  - "*/*"
+`,
+		},
+		"not an object": {
+			document: `null`,
+			wantErrs: []string{"OpenAPI document must be an object"},
+		},
+		"missing required fields": {
+			document: `{"info": {}}`,
+			wantErrs: []string{
+				"openapi: required field is missing",
+				"info.title: required field is missing",
+				"info.version: required field is missing",
+				"paths: required field is missing",
+			},
+		},
+		"unsupported version": {
+			document: `{"openapi": "3.1.0", "info": {"title": "example", "version": "1"}, "paths": {}}`,
+			wantErrs: []string{"openapi: unsupported OpenAPI version (3.1.0), must be 3.0.x"},
+		},
+		"Swagger": {
+			document: `{"swagger": "2.0", "info": {"title": "example", "version": "1"}, "paths": {}}`,
+			wantErrs: []string{
+				"swagger: unsupported field",
+				"openapi: required field is missing",
+			},
+		},
+		"numeric version": {
+			document: "openapi: 3.0.1\ninfo:\n  title: example\n  version: 1.0\npaths: {}\n",
+			wantErrs: []string{"info.version: must be a string"},
+		},
+		"invalid paths": {
+			document: `
+openapi: 3.0.1
+info:
+  title: example
+  version: "1"
+paths:
+  pets:
+    get:
+      operationId: listPets
+      responses: {}
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+      - name: petId
+        in: body
+    fetch:
+      responses:
+        200:
+          description: OK
+    get:
+      operationId: listPets
+      responses:
+        "600":
+          description: Invalid
+        "200": {}
+`,
+			wantErrs: []string{
+				`paths["/pets/{petId}"].fetch: unsupported field`,
+				`paths["/pets/{petId}"].parameters.0: path parameter (petId) must be required`,
+				`paths["/pets/{petId}"].parameters.1.in: invalid parameter location (body), must be one of cookie, header, path, query`,
+				`paths["/pets/{petId}"].get.responses.200.description: required field is missing`,
+				`paths["/pets/{petId}"].get.responses.600: invalid response code, must be "default", an HTTP status code or a range such as 2XX`,
+				`paths.pets: path must begin with a forward slash (/)`,
+				`paths.pets.get.responses: must contain at least one response`,
+				`operationId (listPets) is not unique: paths["/pets/{petId}"].get, paths.pets.get`,
+			},
+		},
+		"missing responses": {
+			document: `{"openapi": "3.0.1", "info": {"title": "example", "version": "1"}, "paths": {"/": {"x-amazon-apigateway-any-method": {}}}}`,
+			wantErrs: []string{`paths["/"].x-amazon-apigateway-any-method.responses: required field is missing`},
+		},
+		"unresolved reference": {
+			document: `{"openapi": "3.0.1", "info": {"title": "example", "version": "1"}, "paths": {"/": {"get": {"responses": {"200": {"$ref": "#/components/responses/Missing"}}}}}}`,
+			wantErrs: []string{`paths["/"].get.responses.200.$ref: unresolved reference (#/components/responses/Missing)`},
+		},
+		"invalid component name": {
+			document: `{"openapi": "3.0.1", "info": {"title": "example", "version": "1"}, "paths": {}, "components": {"schemas": {"My Schema": {}}}}`,
+			wantErrs: []string{"components.schemas: invalid component name (My Schema)"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := Decode(testCase.document)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = doc.Validate()
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected errors: %q", testCase.wantErrs)
+			}
+
+			got := strings.Split(err.Error(), "\n")
+
+			for _, want := range testCase.wantErrs {
+				if !slices.Contains(got, want) {
+					t.Errorf("missing error %q in:\n%s", want, err)
+				}
+			}
+
+			if len(got) != len(testCase.wantErrs) {
+				t.Errorf("got %d errors, want %d:\n%s", len(got), len(testCase.wantErrs), err)
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -316,6 +317,41 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to default resource timeouts by resource type or service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "Default timeout for creating resources.",
+						},
+						"delete": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "Default timeout for deleting resources.",
+						},
+						"read": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "Default timeout for reading resources.",
+						},
+						"resource_type": schema.StringAttribute{
+							Optional:    true,
+							Description: "Resource type, e.g. `aws_db_instance`, to which the timeouts apply. Conflicts with `service`.",
+						},
+						"service": schema.StringAttribute{
+							Optional:    true,
+							Description: "Service, e.g. `rds`, to whose resources the timeouts apply. Conflicts with `resource_type`.",
+						},
+						"update": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "Default timeout for updating resources.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -339,6 +375,59 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate of AWS API requests by service and operation.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of AWS API requests made at once before the rate limit applies. Defaults to `1`.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "AWS API operation, e.g. `ChangeResourceRecordSets`, whose requests are limited. If omitted, all of the service's requests are limited.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "Maximum sustained rate of AWS API requests per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service, e.g. `route53`, whose AWS API requests are limited.",
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to retry additional AWS API errors by service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS API error codes, e.g. `InvalidParameterValue`, to retry.",
+						},
+						"error_message_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching AWS API error messages to retry.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of attempts of each AWS API request for the service.",
+						},
+						"max_backoff": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "Maximum delay between attempts of each AWS API request for the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service, e.g. `iam`, whose AWS API requests the settings apply to.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -387,6 +476,8 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCedarIsAuthorizedFunction,
+		tffunction.NewSubnetPlanFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -596,6 +687,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 					ctx = conns.NewResourceContext(ctx, servicePackageName, res.Name, overrideRegion)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+						ctx = tftimeouts.NewContext(ctx, c.DefaultTimeoutsConfig(ctx).For(servicePackageName, typeName))
 						ctx = c.RegisterLogger(ctx)
 						ctx = fwflex.RegisterLogger(ctx)
 					}
@@ -6,6 +6,7 @@ package sdkv2
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +21,7 @@ type schemaResourceData interface {
 	sdkv2.ResourceDiffer
 	Set(string, any) error
 	Identity() (*schema.IdentityData, error)
+	Timeout(string) time.Duration
 }
 
 type interceptorOptions[D any] struct {
@@ -11,6 +11,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -43,6 +45,8 @@ var (
 type sdkProvider struct {
 	provider        *schema.Provider
 	servicePackages iter.Seq2[int, conns.ServicePackage]
+	// resourceTimeouts holds the timeouts declared by each resource type that supports a `timeouts` configuration block.
+	resourceTimeouts map[string]declaredResourceTimeout
 }
 
 // NewProvider returns a new, initialized Terraform Plugin SDK v2-style provider instance.
@@ -93,6 +97,49 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						},
 					},
 				},
+				"default_timeouts": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with settings to default resource timeouts by resource type or service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"create": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Default timeout for creating resources.",
+								ValidateFunc: verify.ValidDuration,
+							},
+							"delete": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Default timeout for deleting resources.",
+								ValidateFunc: verify.ValidDuration,
+							},
+							"read": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Default timeout for reading resources.",
+								ValidateFunc: verify.ValidDuration,
+							},
+							"resource_type": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Resource type, e.g. `aws_db_instance`, to which the timeouts apply. Conflicts with `service`.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Service, e.g. `rds`, to whose resources the timeouts apply. Conflicts with `resource_type`.",
+							},
+							"update": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Default timeout for updating resources.",
+								ValidateFunc: verify.ValidDuration,
+							},
+						},
+					},
+				},
 				"ec2_metadata_service_endpoint": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -173,12 +220,83 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"rate_limit": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with settings to limit the rate of AWS API requests by service and operation.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"burst": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "Maximum number of AWS API requests made at once before the rate limit applies. Defaults to `1`.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"operation": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "AWS API operation, e.g. `ChangeResourceRecordSets`, whose requests are limited. If omitted, all of the service's requests are limited.",
+							},
+							"requests_per_second": {
+								Type:        schema.TypeFloat,
+								Required:    true,
+								Description: "Maximum sustained rate of AWS API requests per second.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Service, e.g. `route53`, whose AWS API requests are limited.",
+							},
+						},
+					},
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: "The region where AWS operations will take place. Examples\n" +
 						"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				},
+				"retry": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with settings to retry additional AWS API errors by service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"error_codes": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "AWS API error codes, e.g. `InvalidParameterValue`, to retry.",
+							},
+							"error_message_regexes": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringIsValidRegExp,
+								},
+								Description: "Regular expressions matching AWS API error messages to retry.",
+							},
+							"max_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "Maximum number of attempts of each AWS API request for the service.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"max_backoff": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Maximum delay between attempts of each AWS API request for the service.",
+								ValidateFunc: verify.ValidDuration,
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Service, e.g. `iam`, whose AWS API requests the settings apply to.",
+							},
+						},
+					},
+				},
 				"retry_mode": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -278,7 +396,8 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 			DataSourcesMap: make(map[string]*schema.Resource),
 			ResourcesMap:   make(map[string]*schema.Resource),
 		},
-		servicePackages: slices.All(servicePackages(ctx)),
+		servicePackages:  slices.All(servicePackages(ctx)),
+		resourceTimeouts: make(map[string]declaredResourceTimeout),
 	}
 
 	sdkProvider.provider.ConfigureContextFunc = sdkProvider.configure
@@ -465,10 +584,43 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]any)) > 0 {
+		path := cty.GetAttrPath("default_timeouts")
+		defaultTimeouts, dx := expandDefaultTimeouts(ctx, path, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		diags = append(diags, p.validateDefaultTimeouts(ctx, path, defaultTimeouts)...)
+		config.DefaultTimeoutsConfig = defaultTimeouts
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]any)) > 0 {
+		path := cty.GetAttrPath("rate_limit")
+		rateLimits, dx := expandRateLimits(ctx, path, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		diags = append(diags, p.validateRateLimits(ctx, path, rateLimits)...)
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("retry"); ok && len(v.([]any)) > 0 {
+		path := cty.GetAttrPath("retry")
+		retryRules, dx := expandRetryRules(ctx, path, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		diags = append(diags, p.validateRetryRules(ctx, path, retryRules)...)
+		config.RetryRules = retryRules
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -498,6 +650,8 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		return nil, diags
 	}
 
+	p.applyDefaultTimeouts(ctx, config.DefaultTimeoutsConfig)
+
 	return c, diags
 }
 
@@ -726,6 +880,18 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				}
 			}
 
+			if r.Timeouts != nil {
+				p.resourceTimeouts[typeName] = declaredResourceTimeout{
+					servicePackageName: servicePackageName,
+					timeouts:           *r.Timeouts,
+				}
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         AllCRUDOps,
+					interceptor: logTimeouts(),
+				})
+			}
+
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
@@ -1086,6 +1252,145 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	return nil
 }
 
+func expandDefaultTimeouts(_ context.Context, path cty.Path, tfList []any) (*tftimeouts.DefaultConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defaultConfig := &tftimeouts.DefaultConfig{
+		ResourceTypes: make(map[string]tftimeouts.Timeouts),
+		Services:      make(map[string]tftimeouts.Timeouts),
+	}
+
+	for i, tfMapRaw := range tfList {
+		path := path.IndexInt(i)
+		tfMap, _ := tfMapRaw.(map[string]any)
+
+		var timeouts tftimeouts.Timeouts
+		for k, v := range map[string]*time.Duration{
+			"create": &timeouts.Create,
+			"delete": &timeouts.Delete,
+			"read":   &timeouts.Read,
+			"update": &timeouts.Update,
+		} {
+			if s, ok := tfMap[k].(string); ok && s != "" {
+				*v, _ = time.ParseDuration(s)
+			}
+		}
+
+		resourceType, _ := tfMap["resource_type"].(string)
+		service, _ := tfMap["service"].(string)
+
+		switch {
+		case resourceType != "" && service != "":
+			diags = append(diags, errs.NewAttributeConflictsWhenError(path.GetAttr("service"), path.GetAttr("resource_type"), resourceType))
+		case resourceType != "":
+			if _, ok := defaultConfig.ResourceTypes[resourceType]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("resource_type"), "Duplicate default timeouts for resource type %q", resourceType))
+				continue
+			}
+			defaultConfig.ResourceTypes[resourceType] = timeouts
+		case service != "":
+			if _, ok := defaultConfig.Services[service]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Duplicate default timeouts for service %q", service))
+				continue
+			}
+			defaultConfig.Services[service] = timeouts
+		default:
+			diags = append(diags, errs.NewAtLeastOneOfChildrenError(path, path.GetAttr("resource_type"), path.GetAttr("service")))
+		}
+	}
+
+	return defaultConfig, diags
+}
+
+func expandRateLimits(_ context.Context, path cty.Path, tfList []any) (map[string][]*conns.RateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rateLimits := make(map[string][]*conns.RateLimit)
+
+	for i, tfMapRaw := range tfList {
+		path := path.IndexInt(i)
+		tfMap, _ := tfMapRaw.(map[string]any)
+
+		service, _ := tfMap["service"].(string)
+		operation, _ := tfMap["operation"].(string)
+		if slices.ContainsFunc(rateLimits[service], func(v *conns.RateLimit) bool {
+			return v.Operation == operation
+		}) {
+			if operation == "" {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Duplicate rate limit for service %q", service))
+			} else {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("operation"), "Duplicate rate limit for service %q operation %q", service, operation))
+			}
+			continue
+		}
+
+		requestsPerSecond, _ := tfMap["requests_per_second"].(float64)
+		if requestsPerSecond <= 0 {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("requests_per_second"), "Must be greater than 0, got %v", requestsPerSecond))
+			continue
+		}
+
+		rateLimit := &conns.RateLimit{
+			Operation:         operation,
+			RequestsPerSecond: requestsPerSecond,
+		}
+
+		if v, ok := tfMap["burst"].(int); ok {
+			rateLimit.Burst = v
+		}
+
+		rateLimits[service] = append(rateLimits[service], rateLimit)
+	}
+
+	return rateLimits, diags
+}
+
+func expandRetryRules(_ context.Context, path cty.Path, tfList []any) (map[string]*conns.RetryRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	retryRules := make(map[string]*conns.RetryRule)
+
+	for i, tfMapRaw := range tfList {
+		path := path.IndexInt(i)
+		tfMap, _ := tfMapRaw.(map[string]any)
+
+		service, _ := tfMap["service"].(string)
+		if _, ok := retryRules[service]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Duplicate retry settings for service %q", service))
+			continue
+		}
+
+		retryRule := &conns.RetryRule{}
+
+		if v, ok := tfMap["error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			retryRule.ErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["error_message_regexes"].(*schema.Set); ok && v.Len() > 0 {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				re, err := regexp.Compile(v)
+				if err != nil {
+					diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("error_message_regexes"), "Invalid regular expression %q: %s", v, err))
+					continue
+				}
+				retryRule.ErrorMessageRegexes = append(retryRule.ErrorMessageRegexes, re)
+			}
+		}
+
+		if v, ok := tfMap["max_attempts"].(int); ok {
+			retryRule.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			retryRule.MaxBackoff, _ = time.ParseDuration(v)
+		}
+
+		retryRules[service] = retryRule
+	}
+
+	return retryRules, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
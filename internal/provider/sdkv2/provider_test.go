@@ -5,15 +5,22 @@ package sdkv2
 
 import (
 	"os"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -309,6 +316,266 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("default_timeouts")
+	testcases := map[string]struct {
+		tfList         []any
+		expectedConfig *tftimeouts.DefaultConfig
+		expectedDiags  diag.Diagnostics
+	}{
+		"resource type and service": {
+			tfList: []any{
+				map[string]any{
+					"create":  "90m",
+					"delete":  "1h",
+					"service": "rds",
+				},
+				map[string]any{
+					"create":        "2h",
+					"resource_type": "aws_db_instance",
+				},
+			},
+			expectedConfig: &tftimeouts.DefaultConfig{
+				ResourceTypes: map[string]tftimeouts.Timeouts{
+					"aws_db_instance": {
+						Create: 2 * time.Hour,
+					},
+				},
+				Services: map[string]tftimeouts.Timeouts{
+					"rds": {
+						Create: 90 * time.Minute,
+						Delete: time.Hour,
+					},
+				},
+			},
+		},
+		"neither resource type nor service": {
+			tfList: []any{
+				map[string]any{
+					"create": "90m",
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAtLeastOneOfChildrenError(path.IndexInt(0), path.IndexInt(0).GetAttr("resource_type"), path.IndexInt(0).GetAttr("service")),
+			},
+		},
+		"resource type and service in one block": {
+			tfList: []any{
+				map[string]any{
+					"create":        "90m",
+					"resource_type": "aws_db_instance",
+					"service":       "rds",
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeConflictsWhenError(path.IndexInt(0).GetAttr("service"), path.IndexInt(0).GetAttr("resource_type"), "aws_db_instance"),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"create":  "90m",
+					"service": "rds",
+				},
+				map[string]any{
+					"delete":  "90m",
+					"service": "rds",
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(1).GetAttr("service"), "Duplicate default timeouts for service %q", "rds"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config, diags := expandDefaultTimeouts(ctx, path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testcase.expectedConfig == nil {
+				return
+			}
+
+			if diff := cmp.Diff(config, testcase.expectedConfig); diff != "" {
+				t.Errorf("unexpected config difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("rate_limit")
+	testcases := map[string]struct {
+		tfList             []any
+		expectedRateLimits map[string][]*conns.RateLimit
+		expectedDiags      diag.Diagnostics
+	}{
+		"service and operations": {
+			tfList: []any{
+				map[string]any{
+					"requests_per_second": 20.0,
+					"service":             "route53",
+				},
+				map[string]any{
+					"burst":               5,
+					"operation":           "ChangeResourceRecordSets",
+					"requests_per_second": 2.5,
+					"service":             "route53",
+				},
+				map[string]any{
+					"requests_per_second": 1.0,
+					"service":             "organizations",
+				},
+			},
+			expectedRateLimits: map[string][]*conns.RateLimit{
+				"organizations": {
+					{RequestsPerSecond: 1},
+				},
+				"route53": {
+					{RequestsPerSecond: 20},
+					{Operation: "ChangeResourceRecordSets", RequestsPerSecond: 2.5, Burst: 5},
+				},
+			},
+		},
+		"duplicate operation": {
+			tfList: []any{
+				map[string]any{
+					"operation":           "ChangeResourceRecordSets",
+					"requests_per_second": 2.0,
+					"service":             "route53",
+				},
+				map[string]any{
+					"operation":           "ChangeResourceRecordSets",
+					"requests_per_second": 4.0,
+					"service":             "route53",
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(1).GetAttr("operation"), "Duplicate rate limit for service %q operation %q", "route53", "ChangeResourceRecordSets"),
+			},
+		},
+		"zero requests per second": {
+			tfList: []any{
+				map[string]any{
+					"requests_per_second": 0.0,
+					"service":             "route53",
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(0).GetAttr("requests_per_second"), "Must be greater than 0, got %v", 0.0),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rateLimits, diags := expandRateLimits(ctx, path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testcase.expectedRateLimits == nil {
+				return
+			}
+
+			if diff := cmp.Diff(rateLimits, testcase.expectedRateLimits, cmpopts.IgnoreUnexported(conns.RateLimit{})); diff != "" {
+				t.Errorf("unexpected rate limits difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRetryRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("retry")
+	testcases := map[string]struct {
+		tfList        []any
+		expectedRules map[string]*conns.RetryRule
+		expectedDiags diag.Diagnostics
+	}{
+		"error codes and message regexes": {
+			tfList: []any{
+				map[string]any{
+					"error_codes":           schema.NewSet(schema.HashString, []any{"ConcurrentModificationException"}),
+					"error_message_regexes": schema.NewSet(schema.HashString, []any{"role .* cannot be assumed"}),
+					"max_attempts":          10,
+					"max_backoff":           "30s",
+					"service":               "iam",
+				},
+				map[string]any{
+					"max_attempts": 50,
+					"service":      "rds",
+				},
+			},
+			expectedRules: map[string]*conns.RetryRule{
+				"iam": {
+					ErrorCodes:          []string{"ConcurrentModificationException"},
+					ErrorMessageRegexes: []*regexp.Regexp{regexache.MustCompile(`role .* cannot be assumed`)},
+					MaxAttempts:         10,
+					MaxBackoff:          30 * time.Second,
+				},
+				"rds": {
+					MaxAttempts: 50,
+				},
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"max_attempts": 10,
+					"service":      "iam",
+				},
+				map[string]any{
+					"max_attempts": 20,
+					"service":      "iam",
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(1).GetAttr("service"), "Duplicate retry settings for service %q", "iam"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, diags := expandRetryRules(ctx, path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testcase.expectedRules == nil {
+				return
+			}
+
+			if diff := cmp.Diff(rules, testcase.expectedRules, cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				return x.String() == y.String()
+			})); diff != "" {
+				t.Errorf("unexpected rules difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := map[string]struct {
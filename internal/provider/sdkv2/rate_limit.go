@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// validateRateLimits returns warnings for rate limits configured for unknown services.
+func (p *sdkProvider) validateRateLimits(_ context.Context, path cty.Path, rateLimits map[string][]*conns.RateLimit) diag.Diagnostics {
+	return p.validateServices(path, maps.Keys(rateLimits), "Rate limits")
+}
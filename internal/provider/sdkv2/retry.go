@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// validateRetryRules returns warnings for retry settings configured for unknown services.
+func (p *sdkProvider) validateRetryRules(_ context.Context, path cty.Path, retryRules map[string]*conns.RetryRule) diag.Diagnostics {
+	return p.validateServices(path, maps.Keys(retryRules), "Retry settings")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 	"unique"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -164,3 +165,7 @@ func (d *resourceData) HasChanges(keys ...string) bool {
 func (d *resourceData) Identity() (*schema.IdentityData, error) {
 	return nil, nil
 }
+
+func (d *resourceData) Timeout(string) time.Duration {
+	return 20 * time.Minute
+}
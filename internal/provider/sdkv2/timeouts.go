@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
+)
+
+// declaredResourceTimeout is the timeouts declared by a resource type.
+type declaredResourceTimeout struct {
+	servicePackageName string
+	timeouts           schema.ResourceTimeout
+}
+
+// applyDefaultTimeouts sets the timeouts of each resource type to its declared timeouts with any provider-level default timeouts applied.
+// Each provider configuration is served by its own plugin instance, so the defaults are those of the provider configuration.
+// The SDK resolves a resource's timeouts when planning, from these timeouts and the resource's `timeouts` configuration block.
+func (p *sdkProvider) applyDefaultTimeouts(ctx context.Context, defaultConfig *tftimeouts.DefaultConfig) {
+	for typeName, v := range p.resourceTimeouts {
+		r, ok := p.provider.ResourcesMap[typeName]
+		if !ok {
+			continue
+		}
+
+		r.Timeouts = resourceTimeoutWithDefaults(v.timeouts, defaultConfig.For(v.servicePackageName, typeName))
+
+		if defaultConfig != nil {
+			tflog.Debug(ctx, "Applying provider default timeouts", map[string]any{
+				"tf_aws.resource_type": typeName,
+				"tf_aws.timeouts":      resourceTimeoutString(r.Timeouts),
+			})
+		}
+	}
+}
+
+// resourceTimeoutWithDefaults returns the declared timeouts with provider-level defaults applied.
+// Only operations for which a resource declares a timeout are defaulted.
+func resourceTimeoutWithDefaults(declared schema.ResourceTimeout, defaults tftimeouts.Timeouts) *schema.ResourceTimeout {
+	f := func(declared *time.Duration, defaultTimeout time.Duration) *time.Duration {
+		if declared == nil || defaultTimeout == 0 {
+			return declared
+		}
+
+		return &defaultTimeout
+	}
+
+	return &schema.ResourceTimeout{
+		Create:  f(declared.Create, defaults.Create),
+		Read:    f(declared.Read, defaults.Read),
+		Update:  f(declared.Update, defaults.Update),
+		Delete:  f(declared.Delete, defaults.Delete),
+		Default: declared.Default,
+	}
+}
+
+func resourceTimeoutString(timeouts *schema.ResourceTimeout) string {
+	f := func(v *time.Duration) string {
+		if v == nil {
+			return "-"
+		}
+		return v.String()
+	}
+
+	return fmt.Sprintf("create=%s read=%s update=%s delete=%s", f(timeouts.Create), f(timeouts.Read), f(timeouts.Update), f(timeouts.Delete))
+}
+
+// validateDefaultTimeouts returns warnings for any provider-level default timeouts configured for unknown resource types or services.
+func (p *sdkProvider) validateDefaultTimeouts(ctx context.Context, path cty.Path, defaultConfig *tftimeouts.DefaultConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeNames := make(map[string]struct{})
+	for _, sp := range p.servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			typeNames[v.TypeName] = struct{}{}
+		}
+		for _, v := range sp.FrameworkResources(ctx) {
+			typeNames[v.TypeName] = struct{}{}
+		}
+	}
+
+	for k := range defaultConfig.ResourceTypes {
+		if _, ok := typeNames[k]; !ok {
+			diags = append(diags, errs.NewAttributeWarningDiagnostic(path, "Unknown resource type", fmt.Sprintf("Default timeouts are configured for unknown resource type %q.", k)))
+		}
+	}
+
+	diags = append(diags, p.validateServices(path, maps.Keys(defaultConfig.Services), "Default timeouts")...)
+
+	return diags
+}
+
+// logTimeouts returns an interceptor that logs the effective timeout of each CRUD operation.
+func logTimeouts() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			var key string
+			switch why {
+			case Create:
+				key = schema.TimeoutCreate
+			case Read:
+				key = schema.TimeoutRead
+			case Update:
+				key = schema.TimeoutUpdate
+			case Delete:
+				key = schema.TimeoutDelete
+			default:
+				return diags
+			}
+
+			tflog.Debug(ctx, "Effective resource timeout", map[string]any{
+				"tf_aws.timeout." + key: d.Timeout(key).String(),
+			})
+		}
+
+		return diags
+	})
+}
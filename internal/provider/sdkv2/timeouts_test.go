@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tftimeouts "github.com/hashicorp/terraform-provider-aws/internal/timeouts"
+)
+
+func TestResourceTimeoutWithDefaults(t *testing.T) {
+	t.Parallel()
+
+	declared := schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(40 * time.Minute),
+		Update: schema.DefaultTimeout(80 * time.Minute),
+		Delete: schema.DefaultTimeout(60 * time.Minute),
+	}
+
+	testCases := map[string]struct {
+		defaults tftimeouts.Timeouts
+		want     *schema.ResourceTimeout
+	}{
+		"no defaults": {
+			want: &declared,
+		},
+		// Read is not declared by the resource and so is not defaulted.
+		"defaults": {
+			defaults: tftimeouts.Timeouts{
+				Create: 90 * time.Minute,
+				Read:   10 * time.Minute,
+				Delete: 2 * time.Hour,
+			},
+			want: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(90 * time.Minute),
+				Update: schema.DefaultTimeout(80 * time.Minute),
+				Delete: schema.DefaultTimeout(2 * time.Hour),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resourceTimeoutWithDefaults(declared, testCase.defaults)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected timeouts difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	declared := schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(40 * time.Minute),
+	}
+	r := &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+		},
+	}
+	p := &sdkProvider{
+		provider: &schema.Provider{
+			ResourcesMap: map[string]*schema.Resource{
+				"aws_db_instance": r,
+			},
+		},
+		resourceTimeouts: map[string]declaredResourceTimeout{
+			"aws_db_instance": {
+				servicePackageName: "rds",
+				timeouts:           declared,
+			},
+		},
+	}
+
+	p.applyDefaultTimeouts(ctx, &tftimeouts.DefaultConfig{
+		Services: map[string]tftimeouts.Timeouts{
+			"rds": {Create: 90 * time.Minute},
+		},
+	})
+
+	if got, want := *r.Timeouts.Create, 90*time.Minute; got != want {
+		t.Errorf("Create timeout = %s, want %s", got, want)
+	}
+
+	// A timeout set in the resource's `timeouts` configuration block takes precedence.
+	var timeouts schema.ResourceTimeout
+	if err := timeouts.ConfigDecode(r, terraform.NewResourceConfigRaw(map[string]any{
+		schema.TimeoutsConfigKey: map[string]any{
+			schema.TimeoutCreate: "2h",
+		},
+	})); err != nil {
+		t.Fatalf("decoding timeouts: %s", err)
+	}
+	if got, want := *timeouts.Create, 2*time.Hour; got != want {
+		t.Errorf("configured Create timeout = %s, want %s", got, want)
+	}
+
+	// Without defaults, the declared timeouts are restored.
+	p.applyDefaultTimeouts(ctx, nil)
+
+	if got, want := *r.Timeouts.Create, 40*time.Minute; got != want {
+		t.Errorf("Create timeout = %s, want %s", got, want)
+	}
+	if got, want := *declared.Create, 40*time.Minute; got != want {
+		t.Errorf("declared Create timeout = %s, want %s", got, want)
+	}
+}
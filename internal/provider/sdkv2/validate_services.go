@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// validateServices returns warnings for provider settings, described by what, configured for unknown services.
+// It is used to validate the services named in the `default_timeouts`, `retry` and `rate_limit` configuration blocks.
+func (p *sdkProvider) validateServices(path cty.Path, services iter.Seq[string], what string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	servicePackageNames := make(map[string]struct{})
+	for _, sp := range p.servicePackages {
+		servicePackageNames[sp.ServicePackageName()] = struct{}{}
+	}
+
+	for k := range services {
+		if _, ok := servicePackageNames[k]; !ok {
+			diags = append(diags, errs.NewAttributeWarningDiagnostic(path, "Unknown service", fmt.Sprintf("%s are configured for unknown service %q.", what, k)))
+		}
+	}
+
+	return diags
+}
@@ -4,6 +4,9 @@
 package semver
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
 
 	gversion "github.com/hashicorp/go-version"
@@ -44,6 +47,100 @@ func MajorMinor(s string) (string, error) {
 	return strconv.FormatInt(v.Segments64()[0], 10) + `.` + strconv.FormatInt(v.Segments64()[1], 10), nil
 }
 
+// DefaultVersionRegex matches the first dotted version number in a string, e.g. "1.29" in "amazon-eks-node-1.29-v20240315".
+const DefaultVersionRegex = `\d+(?:\.\d+)+`
+
+// Selector extracts versions from strings and matches them against a version constraint.
+type Selector struct {
+	constraints gversion.Constraints
+	regex       *regexp.Regexp
+}
+
+// NewSelector returns a Selector that extracts versions using versionRegex and matches them against constraint.
+// If versionRegex contains a capture group, the first group is the version; otherwise the whole match is.
+// An empty versionRegex defaults to DefaultVersionRegex and an empty constraint matches any version.
+func NewSelector(versionRegex, constraint string) (*Selector, error) {
+	if versionRegex == "" {
+		versionRegex = DefaultVersionRegex
+	}
+
+	regex, err := regexp.Compile(versionRegex)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing version regex (%s): %w", versionRegex, err)
+	}
+
+	s := &Selector{
+		regex: regex,
+	}
+
+	if constraint != "" {
+		s.constraints, err = gversion.NewConstraint(constraint)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing version constraint (%s): %w", constraint, err)
+		}
+	}
+
+	return s, nil
+}
+
+// Version returns the version extracted from str and whether it satisfies the constraint.
+// It returns false if no version can be extracted from str.
+func (s *Selector) Version(str string) (*gversion.Version, bool) {
+	m := s.regex.FindStringSubmatch(str)
+
+	if m == nil {
+		return nil, false
+	}
+
+	match := m[0]
+	if len(m) > 1 {
+		match = m[1]
+	}
+
+	v, err := gversion.NewVersion(match)
+
+	if err != nil {
+		return nil, false
+	}
+
+	if s.constraints != nil && !s.constraints.Check(v) {
+		return nil, false
+	}
+
+	return v, true
+}
+
+// Select returns the elements of items whose version, extracted from the string returned by f,
+// satisfies the constraint. The elements are sorted by ascending version; the order of elements
+// with equal versions is preserved.
+func Select[T any](s *Selector, items []T, f func(T) string) []T {
+	type item struct {
+		item    T
+		version *gversion.Version
+	}
+
+	var matched []item
+
+	for _, v := range items {
+		if version, ok := s.Version(f(v)); ok {
+			matched = append(matched, item{item: v, version: version})
+		}
+	}
+
+	slices.SortStableFunc(matched, func(a, b item) int {
+		return a.version.Compare(b.version)
+	})
+
+	result := make([]T, 0, len(matched))
+	for _, v := range matched {
+		result = append(result, v.item)
+	}
+
+	return result
+}
+
 func parseVersions(s1, s2 string) (*gversion.Version, *gversion.Version, error) {
 	v1, err := gversion.NewVersion(s1)
 
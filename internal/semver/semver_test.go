@@ -4,6 +4,7 @@
 package semver
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -68,3 +69,72 @@ func TestSemVerMajorMinor(t *testing.T) {
 		}
 	}
 }
+
+func TestSelectorVersion(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		regex      string
+		constraint string
+		s          string
+		want       string
+		ok         bool
+	}{
+		{"", "", "amazon-eks-node-1.29-v20240315", "1.29.0", true},
+		{"", "~> 1.29.0", "amazon-eks-node-1.30-v20240315", "", false},
+		{"", ">= 16, < 17", "16.4", "16.4.0", true},
+		{"", ">= 16, < 17", "15.8", "", false},
+		{`mysql_aurora\.(\d+\.\d+\.\d+)`, "", "8.0.mysql_aurora.3.05.2", "3.5.2", true},
+		{`mysql_aurora\.(\d+\.\d+\.\d+)`, "", "8.0.32", "", false},
+		{`-(\d+\.\d+)-v`, "~> 1.29", "amazon-eks-node-1.29-v20240315", "1.29.0", true},
+		{"", "", "no version here", "", false},
+	} {
+		s, err := NewSelector(tc.regex, tc.constraint)
+		if err != nil {
+			t.Fatalf("NewSelector(%q, %q): %s", tc.regex, tc.constraint, err)
+		}
+
+		v, ok := s.Version(tc.s)
+		if ok != tc.ok {
+			t.Fatalf("Version(%q) ok = %t, want %t", tc.s, ok, tc.ok)
+		}
+		if ok && v.String() != tc.want {
+			t.Fatalf("Version(%q) = %s, want %s", tc.s, v, tc.want)
+		}
+	}
+}
+
+func TestNewSelectorInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewSelector("(", ""); err == nil {
+		t.Fatal("expected error for invalid regex")
+	}
+
+	if _, err := NewSelector("", "not a constraint"); err == nil {
+		t.Fatal("expected error for invalid constraint")
+	}
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewSelector("", "~> 1.29.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Select(s, []string{
+		"node-1.29.10-b",
+		"node-1.30.1",
+		"node-1.29.2",
+		"node-1.29.10-a",
+		"node-1.28.9",
+		"node",
+	}, func(v string) string { return v })
+
+	want := []string{"node-1.29.2", "node-1.29.10-b", "node-1.29.10-a"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Select() = %v, want %v", got, want)
+	}
+}
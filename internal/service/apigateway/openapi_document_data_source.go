@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/openapi"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// defaultOpenAPIVersion is the OpenAPI version of generated documents that don't specify one.
+	defaultOpenAPIVersion = "3.0.1"
+)
+
+// @SDKDataSource("aws_api_gateway_openapi_document", name="OpenAPI Document")
+// @Region(overrideEnabled=false)
+func dataSourceOpenAPIDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceOpenAPIDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			documentsSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidStringIsJSONOrYAML,
+					},
+				}
+			}
+
+			return map[string]*schema.Schema{
+				names.AttrDescription: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"integration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"cache_key_parameters": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"cache_namespace": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							names.AttrConnectionID: {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"connection_type": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[types.ConnectionType](),
+							},
+							"content_handling": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: validIntegrationContentHandling(),
+							},
+							"credentials": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"http_method": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validHTTPMethod(),
+							},
+							"integration_http_method": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validHTTPMethod(),
+							},
+							"passthrough_behavior": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"WHEN_NO_MATCH",
+									"WHEN_NO_TEMPLATES",
+									"NEVER",
+								}, false),
+							},
+							names.AttrPath: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile(`^/`), "must begin with a forward slash (/)"),
+							},
+							"payload_format_version": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"1.0", "2.0"}, false),
+							},
+							"request_parameters": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"request_templates": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"response": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"content_handling": {
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: validIntegrationContentHandling(),
+										},
+										"response_parameters": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"response_templates": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"selection_pattern": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										names.AttrStatusCode: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"timeout_milliseconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(50, 300000),
+							},
+							names.AttrType: {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: enum.Validate[types.IntegrationType](),
+							},
+							names.AttrURI: {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				names.AttrJSON: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"override_documents": documentsSchema(),
+				"source_documents":   documentsSchema(),
+				"title": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrVersion: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceOpenAPIDocumentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := make(openapi.Document)
+
+	for i, v := range d.Get("source_documents").([]any) {
+		src, err := openapi.Decode(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing API Gateway OpenAPI Document: decoding source document %d: %s", i, err)
+		}
+
+		if err := doc.Merge(src, false); err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing API Gateway OpenAPI Document: merging source document %d: %s", i, err)
+		}
+	}
+
+	if err := addOpenAPIDocumentIntegrations(doc, d.Get("integration").([]any)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing API Gateway OpenAPI Document: %s", err)
+	}
+
+	for i, v := range d.Get("override_documents").([]any) {
+		src, err := openapi.Decode(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing API Gateway OpenAPI Document: decoding override document %d: %s", i, err)
+		}
+
+		if err := doc.Merge(src, true); err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing API Gateway OpenAPI Document: merging override document %d: %s", i, err)
+		}
+	}
+
+	info := make(openapi.Document)
+	for _, k := range []string{names.AttrDescription, "title", names.AttrVersion} {
+		if v, ok := d.GetOk(k); ok {
+			info[k] = v.(string)
+		}
+	}
+	if len(info) > 0 {
+		if err := doc.Merge(openapi.Document{"info": map[string]any(info)}, true); err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing API Gateway OpenAPI Document: %s", err)
+		}
+	}
+
+	if _, ok := doc["openapi"]; !ok {
+		doc["openapi"] = defaultOpenAPIVersion
+	}
+	if _, ok := doc["paths"]; !ok {
+		doc["paths"] = map[string]any{}
+	}
+
+	if err := doc.Validate(); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing API Gateway OpenAPI Document: invalid OpenAPI 3.0 document:\n%s", err)
+	}
+
+	jsonString, err := doc.JSON()
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing API Gateway OpenAPI Document: formatting JSON: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+// addOpenAPIDocumentIntegrations sets the `x-amazon-apigateway-integration` extension of each integration's operation,
+// adding the operation with a default response if it isn't already in the document.
+func addOpenAPIDocumentIntegrations(doc openapi.Document, tfList []any) error {
+	paths, ok := doc["paths"].(map[string]any)
+
+	if _, exists := doc["paths"]; exists && !ok {
+		return fmt.Errorf("paths must be an object")
+	}
+
+	seen := make(map[string]bool)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		path, httpMethod := tfMap[names.AttrPath].(string), tfMap["http_method"].(string)
+
+		key := httpMethod + " " + path
+		if seen[key] {
+			return fmt.Errorf("duplicate integration for %s", key)
+		}
+		seen[key] = true
+
+		if paths == nil {
+			paths = make(map[string]any)
+			doc["paths"] = paths
+		}
+
+		if _, ok := paths[path]; !ok {
+			paths[path] = make(map[string]any)
+		}
+
+		item, ok := paths[path].(map[string]any)
+
+		if !ok {
+			return fmt.Errorf("path item (%s) must be an object", path)
+		}
+
+		method := strings.ToLower(httpMethod)
+		if httpMethod == "ANY" {
+			method = openapi.ExtensionAnyMethod
+		}
+
+		if _, ok := item[method]; !ok {
+			item[method] = map[string]any{
+				"responses": map[string]any{
+					"default": map[string]any{
+						names.AttrDescription: fmt.Sprintf("Default response for %s %s", httpMethod, path),
+					},
+				},
+			}
+		}
+
+		operation, ok := item[method].(map[string]any)
+
+		if !ok {
+			return fmt.Errorf("operation (%s %s) must be an object", httpMethod, path)
+		}
+
+		operation[openapi.ExtensionIntegration] = expandOpenAPIIntegration(tfMap)
+	}
+
+	return nil
+}
+
+func expandOpenAPIIntegration(tfMap map[string]any) map[string]any {
+	apiObject := map[string]any{
+		names.AttrType: strings.ToLower(tfMap[names.AttrType].(string)),
+	}
+
+	for tfKey, apiKey := range map[string]string{
+		"cache_namespace":         "cacheNamespace",
+		names.AttrConnectionID:    "connectionId",
+		"connection_type":         "connectionType",
+		"content_handling":        "contentHandling",
+		"credentials":             "credentials",
+		"integration_http_method": "httpMethod",
+		"payload_format_version":  "payloadFormatVersion",
+		names.AttrURI:             "uri",
+	} {
+		if v, ok := tfMap[tfKey].(string); ok && v != "" {
+			apiObject[apiKey] = v
+		}
+	}
+
+	if v, ok := tfMap["passthrough_behavior"].(string); ok && v != "" {
+		apiObject["passthroughBehavior"] = strings.ToLower(v)
+	}
+
+	if v, ok := tfMap["timeout_milliseconds"].(int); ok && v != 0 {
+		apiObject["timeoutInMillis"] = v
+	}
+
+	if v, ok := tfMap["cache_key_parameters"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject["cacheKeyParameters"] = slices.Sorted(slices.Values(flex.ExpandStringValueSet(v)))
+	}
+
+	if v, ok := tfMap["request_parameters"].(map[string]any); ok && len(v) > 0 {
+		apiObject["requestParameters"] = v
+	}
+
+	if v, ok := tfMap["request_templates"].(map[string]any); ok && len(v) > 0 {
+		apiObject["requestTemplates"] = v
+	}
+
+	if v, ok := tfMap["response"].([]any); ok && len(v) > 0 {
+		responses := make(map[string]any)
+
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+
+			if !ok {
+				continue
+			}
+
+			response := map[string]any{
+				"statusCode": tfMap[names.AttrStatusCode].(string),
+			}
+
+			if v, ok := tfMap["content_handling"].(string); ok && v != "" {
+				response["contentHandling"] = v
+			}
+
+			if v, ok := tfMap["response_parameters"].(map[string]any); ok && len(v) > 0 {
+				response["responseParameters"] = v
+			}
+
+			if v, ok := tfMap["response_templates"].(map[string]any); ok && len(v) > 0 {
+				response["responseTemplates"] = v
+			}
+
+			responses[tfMap["selection_pattern"].(string)] = response
+		}
+
+		apiObject["responses"] = responses
+	}
+
+	return apiObject
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayOpenAPIDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_api_gateway_openapi_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenAPIDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccOpenAPIDocumentDataSourceConfig_basicExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayOpenAPIDocumentDataSource_conflict(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOpenAPIDocumentDataSourceConfig_conflict,
+				ExpectError: regexache.MustCompile(`merging source document 1: conflicting values for paths\["/pets"\]\.get\.operationId`),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayOpenAPIDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOpenAPIDocumentDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`info\.version: required field is missing`),
+			},
+		},
+	})
+}
+
+const testAccOpenAPIDocumentDataSourceConfig_basic = `
+data "aws_api_gateway_openapi_document" "test" {
+  title   = "example"
+  version = "1.0"
+
+  source_documents = [
+    jsonencode({
+      paths = {
+        "/pets" = {
+          get = {
+            operationId = "listPets"
+            responses = {
+              "200" = {
+                description = "OK"
+              }
+            }
+          }
+        }
+      }
+    }),
+    <<EOT
+paths:
+  /pets/{petId}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        200:
+          description: OK
+x-amazon-apigateway-binary-media-types:
+  - image/png
+EOT
+  ]
+
+  integration {
+    path        = "/pets"
+    http_method = "GET"
+    type        = "HTTP_PROXY"
+    uri         = "https://example.com/pets"
+
+    integration_http_method = "GET"
+  }
+
+  integration {
+    path        = "/pets/{petId}"
+    http_method = "ANY"
+    type        = "MOCK"
+
+    request_templates = {
+      "application/json" = "{\"statusCode\": 200}"
+    }
+
+    response {
+      selection_pattern = "default"
+      status_code       = "200"
+    }
+  }
+
+  override_documents = [
+    jsonencode({
+      x-amazon-apigateway-binary-media-types = ["*/*"]
+    }),
+  ]
+}
+`
+
+const testAccOpenAPIDocumentDataSourceConfig_basicExpectedJSON = `{
+  "openapi": "3.0.1",
+  "info": {
+    "title": "example",
+    "version": "1.0"
+  },
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "listPets",
+        "responses": {
+          "200": {
+            "description": "OK"
+          }
+        },
+        "x-amazon-apigateway-integration": {
+          "httpMethod": "GET",
+          "type": "http_proxy",
+          "uri": "https://example.com/pets"
+        }
+      }
+    },
+    "/pets/{petId}": {
+      "get": {
+        "operationId": "getPet",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "petId",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          }
+        }
+      },
+      "x-amazon-apigateway-any-method": {
+        "responses": {
+          "default": {
+            "description": "Default response for ANY /pets/{petId}"
+          }
+        },
+        "x-amazon-apigateway-integration": {
+          "requestTemplates": {
+            "application/json": "{\"statusCode\": 200}"
+          },
+          "responses": {
+            "default": {
+              "statusCode": "200"
+            }
+          },
+          "type": "mock"
+        }
+      }
+    }
+  },
+  "x-amazon-apigateway-binary-media-types": [
+    "*/*"
+  ]
+}`
+
+const testAccOpenAPIDocumentDataSourceConfig_conflict = `
+data "aws_api_gateway_openapi_document" "test" {
+  title   = "example"
+  version = "1.0"
+
+  source_documents = [
+    jsonencode({
+      paths = {
+        "/pets" = {
+          get = {
+            operationId = "listPets"
+            responses = {
+              "200" = {
+                description = "OK"
+              }
+            }
+          }
+        }
+      }
+    }),
+    jsonencode({
+      paths = {
+        "/pets" = {
+          get = {
+            operationId = "getPets"
+          }
+        }
+      }
+    }),
+  ]
+}
+`
+
+const testAccOpenAPIDocumentDataSourceConfig_invalid = `
+data "aws_api_gateway_openapi_document" "test" {
+  title = "example"
+
+  integration {
+    path        = "/"
+    http_method = "GET"
+    type        = "MOCK"
+  }
+}
+`
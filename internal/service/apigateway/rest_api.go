@@ -68,8 +68,9 @@ func resourceRestAPI() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"body": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentOpenAPIDiffs,
 			},
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
@@ -509,6 +509,37 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_bodyEquivalent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.GetRestApiOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRESTAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIConfig_body(rName, "/test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+				),
+			},
+			// Same specification in YAML format.
+			{
+				Config: testAccRestAPIConfig_bodyYAML(rName, "/test"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetRestApiOutput
@@ -2377,6 +2408,36 @@ resource "aws_api_gateway_rest_api" "test" {
 `, rName, basePath)
 }
 
+func testAccRestAPIConfig_bodyYAML(rName string, basePath string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+
+  body = <<EOT
+swagger: "2.0"
+schemes:
+  - https
+paths:
+  %[2]s:
+    get:
+      x-amazon-apigateway-integration:
+        uri: https://api.example.com/
+        type: HTTP
+        httpMethod: GET
+        responses:
+          default:
+            statusCode: 200
+      responses:
+        200:
+          description: OK
+info:
+  version: 2017-04-20T04:08:08Z
+  title: test
+EOT
+}
+`, rName, basePath)
+}
+
 func testAccRestAPIConfig_description(rName string, description string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
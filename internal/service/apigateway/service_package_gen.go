@@ -93,6 +93,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Export",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourceOpenAPIDocument,
+			TypeName: "aws_api_gateway_openapi_document",
+			Name:     "OpenAPI Document",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  dataSourceResource,
 			TypeName: "aws_api_gateway_resource",
@@ -65,7 +65,7 @@ func resourceAPI() *schema.Resource {
 			"body": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+				DiffSuppressFunc: verify.SuppressEquivalentOpenAPIDiffs,
 				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
 			},
 			"cors_configuration": {
@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"mime"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// isJSONContentType returns whether a configuration content type is JSON, e.g. "application/json".
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// validateJSONSchemaValidatorContent checks that the content of a JSON_SCHEMA validator is a valid JSON Schema.
+func validateJSONSchemaValidatorContent(schema string) error {
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema)); err != nil {
+		return fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+
+	return nil
+}
+
+// validateConfigurationContentWithJSONSchema validates configuration content against the content of a JSON_SCHEMA validator.
+func validateConfigurationContentWithJSONSchema(schema, content string) error {
+	s, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema))
+
+	if err != nil {
+		return fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+
+	result, err := s.Validate(gojsonschema.NewStringLoader(content))
+
+	if err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	var errs []error
+	for _, v := range result.Errors() {
+		errs = append(errs, errors.New(v.String()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// AWS.AppConfig.FeatureFlags configuration content.
+// See https://docs.aws.amazon.com/appconfig/latest/userguide/appconfig-type-reference-feature-flags.html.
+
+const (
+	featureFlagsVersion = "1"
+)
+
+const (
+	featureFlagAttributeTypeBoolean     = "boolean"
+	featureFlagAttributeTypeNumber      = "number"
+	featureFlagAttributeTypeNumberArray = "number[]"
+	featureFlagAttributeTypeString      = "string"
+	featureFlagAttributeTypeStringArray = "string[]"
+)
+
+var (
+	featureFlagAttributeTypes = []string{
+		featureFlagAttributeTypeBoolean,
+		featureFlagAttributeTypeNumber,
+		featureFlagAttributeTypeNumberArray,
+		featureFlagAttributeTypeString,
+		featureFlagAttributeTypeStringArray,
+	}
+
+	featureFlagKeyRegexp = regexache.MustCompile(`^[A-Za-z][0-9A-Za-z_-]{0,63}$`)
+)
+
+type featureFlagsContent struct {
+	Version *string                   `json:"version"`
+	Flags   map[string]*featureFlag   `json:"flags"`
+	Values  map[string]map[string]any `json:"values"`
+}
+
+type featureFlag struct {
+	Name        *string                          `json:"name"`
+	Description *string                          `json:"description"`
+	Attributes  map[string]*featureFlagAttribute `json:"attributes"`
+}
+
+type featureFlagAttribute struct {
+	Constraints *featureFlagConstraints `json:"constraints"`
+}
+
+type featureFlagConstraints struct {
+	Type     string                         `json:"type"`
+	Required bool                           `json:"required"`
+	Pattern  *string                        `json:"pattern"`
+	Enum     []any                          `json:"enum"`
+	Minimum  *json.Number                   `json:"minimum"`
+	Maximum  *json.Number                   `json:"maximum"`
+	Elements *featureFlagElementConstraints `json:"elements"`
+}
+
+type featureFlagElementConstraints struct {
+	Type    string       `json:"type"`
+	Pattern *string      `json:"pattern"`
+	Enum    []any        `json:"enum"`
+	Minimum *json.Number `json:"minimum"`
+	Maximum *json.Number `json:"maximum"`
+}
+
+// validateFeatureFlagsContent validates AWS.AppConfig.FeatureFlags configuration content: the flag and attribute
+// definitions, and that each flag value is for a defined flag and has attribute values that satisfy the attribute constraints.
+func validateFeatureFlagsContent(content string) error {
+	var document featureFlagsContent
+
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&document); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	var errs []error
+
+	if v := document.Version; v == nil {
+		errs = append(errs, errors.New("version: required"))
+	} else if *v != featureFlagsVersion {
+		errs = append(errs, fmt.Errorf("version: must be %q, got %q", featureFlagsVersion, *v))
+	}
+
+	if document.Flags == nil {
+		errs = append(errs, errors.New("flags: required"))
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(document.Flags)) {
+		path := "flags." + key
+
+		if !featureFlagKeyRegexp.MatchString(key) {
+			errs = append(errs, fmt.Errorf("%s: invalid flag key", path))
+		}
+
+		flag := document.Flags[key]
+		if flag == nil {
+			errs = append(errs, fmt.Errorf("%s: must be an object", path))
+			continue
+		}
+
+		if flag.Name == nil || *flag.Name == "" {
+			errs = append(errs, fmt.Errorf("%s.name: required", path))
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(flag.Attributes)) {
+			path := path + ".attributes." + key
+
+			if !featureFlagKeyRegexp.MatchString(key) {
+				errs = append(errs, fmt.Errorf("%s: invalid attribute key", path))
+			}
+
+			if attribute := flag.Attributes[key]; attribute != nil && attribute.Constraints != nil {
+				errs = append(errs, attribute.Constraints.validate(path+".constraints")...)
+			}
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(document.Values)) {
+		path := "values." + key
+
+		flag, ok := document.Flags[key]
+		if !ok || flag == nil {
+			errs = append(errs, fmt.Errorf("%s: undefined flag", path))
+			continue
+		}
+
+		value := document.Values[key]
+		if value == nil {
+			errs = append(errs, fmt.Errorf("%s: must be an object", path))
+			continue
+		}
+
+		if v, ok := value["enabled"]; !ok {
+			errs = append(errs, fmt.Errorf("%s.enabled: required", path))
+		} else if _, ok := v.(bool); !ok {
+			errs = append(errs, fmt.Errorf("%s.enabled: must be a boolean", path))
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			// "enabled" and reserved keys such as "_variants", "_createdAt" and "_updatedAt".
+			if key == "enabled" || strings.HasPrefix(key, "_") {
+				continue
+			}
+
+			path := path + "." + key
+
+			attribute, ok := flag.Attributes[key]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: undefined attribute", path))
+				continue
+			}
+
+			if attribute != nil && attribute.Constraints != nil {
+				errs = append(errs, attribute.Constraints.validateValue(path, value[key])...)
+			}
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(flag.Attributes)) {
+			if attribute := flag.Attributes[key]; attribute != nil && attribute.Constraints != nil && attribute.Constraints.Required {
+				if _, ok := value[key]; !ok {
+					errs = append(errs, fmt.Errorf("%s.%s: required", path, key))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *featureFlagConstraints) validate(path string) []error {
+	var errs []error
+
+	if !slices.Contains(featureFlagAttributeTypes, c.Type) {
+		return append(errs, fmt.Errorf("%s.type: must be one of %s, got %q", path, strings.Join(featureFlagAttributeTypes, ", "), c.Type))
+	}
+
+	switch c.Type {
+	case featureFlagAttributeTypeBoolean:
+		if c.Pattern != nil || c.Enum != nil || c.Minimum != nil || c.Maximum != nil || c.Elements != nil {
+			errs = append(errs, fmt.Errorf("%s: only the required constraint applies to %s attributes", path, c.Type))
+		}
+	case featureFlagAttributeTypeNumberArray, featureFlagAttributeTypeStringArray:
+		if c.Pattern != nil || c.Enum != nil || c.Minimum != nil || c.Maximum != nil {
+			errs = append(errs, fmt.Errorf("%s: %s attribute constraints must be specified in elements", path, c.Type))
+		}
+
+		if e := c.Elements; e != nil {
+			if elementType := strings.TrimSuffix(c.Type, "[]"); e.Type != "" && e.Type != elementType {
+				errs = append(errs, fmt.Errorf("%s.elements.type: must be %q, got %q", path, elementType, e.Type))
+			}
+
+			errs = append(errs, validateFeatureFlagScalarConstraints(path+".elements", strings.TrimSuffix(c.Type, "[]"), e.Pattern, e.Enum, e.Minimum, e.Maximum)...)
+		}
+	default:
+		if c.Elements != nil {
+			errs = append(errs, fmt.Errorf("%s.elements: only applies to array attributes", path))
+		}
+
+		errs = append(errs, validateFeatureFlagScalarConstraints(path, c.Type, c.Pattern, c.Enum, c.Minimum, c.Maximum)...)
+	}
+
+	return errs
+}
+
+func validateFeatureFlagScalarConstraints(path, typ string, pattern *string, enum []any, minimum, maximum *json.Number) []error {
+	var errs []error
+
+	switch typ {
+	case featureFlagAttributeTypeString:
+		if minimum != nil || maximum != nil {
+			errs = append(errs, fmt.Errorf("%s: minimum and maximum only apply to number attributes", path))
+		}
+
+		for i, v := range enum {
+			if _, ok := v.(string); !ok {
+				errs = append(errs, fmt.Errorf("%s.enum[%d]: must be a string", path, i))
+			}
+		}
+	case featureFlagAttributeTypeNumber:
+		if pattern != nil {
+			errs = append(errs, fmt.Errorf("%s.pattern: only applies to string attributes", path))
+		}
+
+		for i, v := range enum {
+			if _, ok := v.(json.Number); !ok {
+				errs = append(errs, fmt.Errorf("%s.enum[%d]: must be a number", path, i))
+			}
+		}
+
+		if minimum != nil && maximum != nil && compareJSONNumbers(*minimum, *maximum) > 0 {
+			errs = append(errs, fmt.Errorf("%s: minimum (%s) must not be greater than maximum (%s)", path, *minimum, *maximum))
+		}
+	}
+
+	return errs
+}
+
+func (c *featureFlagConstraints) validateValue(path string, value any) []error {
+	switch c.Type {
+	case featureFlagAttributeTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return []error{fmt.Errorf("%s: must be a boolean", path)}
+		}
+	case featureFlagAttributeTypeNumber, featureFlagAttributeTypeString:
+		return validateFeatureFlagScalarValue(path, c.Type, value, c.Pattern, c.Enum, c.Minimum, c.Maximum)
+	case featureFlagAttributeTypeNumberArray, featureFlagAttributeTypeStringArray:
+		elements, ok := value.([]any)
+		if !ok {
+			return []error{fmt.Errorf("%s: must be an array", path)}
+		}
+
+		var errs []error
+		for i, element := range elements {
+			typ := strings.TrimSuffix(c.Type, "[]")
+
+			if e := c.Elements; e != nil {
+				errs = append(errs, validateFeatureFlagScalarValue(fmt.Sprintf("%s[%d]", path, i), typ, element, e.Pattern, e.Enum, e.Minimum, e.Maximum)...)
+			} else {
+				errs = append(errs, validateFeatureFlagScalarValue(fmt.Sprintf("%s[%d]", path, i), typ, element, nil, nil, nil, nil)...)
+			}
+		}
+
+		return errs
+	}
+
+	return nil
+}
+
+// matchFeatureFlagPattern reports whether v matches pattern.
+// An error is returned if pattern can't be compiled.
+func matchFeatureFlagPattern(pattern, v string) (bool, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(v), nil
+}
+
+func validateFeatureFlagScalarValue(path, typ string, value any, pattern *string, enum []any, minimum, maximum *json.Number) []error {
+	var errs []error
+
+	switch typ {
+	case featureFlagAttributeTypeString:
+		v, ok := value.(string)
+		if !ok {
+			return append(errs, fmt.Errorf("%s: must be a string", path))
+		}
+
+		// AppConfig patterns are ECMAScript regular expressions, which aren't all supported by Go. Skip those that aren't.
+		if pattern != nil {
+			if matched, err := matchFeatureFlagPattern(*pattern, v); err == nil && !matched {
+				errs = append(errs, fmt.Errorf("%s: %q does not match pattern %q", path, v, *pattern))
+			}
+		}
+
+		if enum != nil && !slices.Contains(enum, any(v)) {
+			errs = append(errs, fmt.Errorf("%s: %q is not one of the allowed values", path, v))
+		}
+	case featureFlagAttributeTypeNumber:
+		v, ok := value.(json.Number)
+		if !ok {
+			return append(errs, fmt.Errorf("%s: must be a number", path))
+		}
+
+		if minimum != nil && compareJSONNumbers(v, *minimum) < 0 {
+			errs = append(errs, fmt.Errorf("%s: %s is less than the minimum (%s)", path, v, *minimum))
+		}
+
+		if maximum != nil && compareJSONNumbers(v, *maximum) > 0 {
+			errs = append(errs, fmt.Errorf("%s: %s is greater than the maximum (%s)", path, v, *maximum))
+		}
+
+		if enum != nil && !slices.ContainsFunc(enum, func(e any) bool {
+			n, ok := e.(json.Number)
+			return ok && compareJSONNumbers(v, n) == 0
+		}) {
+			errs = append(errs, fmt.Errorf("%s: %s is not one of the allowed values", path, v))
+		}
+	}
+
+	return errs
+}
+
+func compareJSONNumbers(x, y json.Number) int {
+	a, _, errA := big.ParseFloat(x.String(), 10, 256, big.ToNearestEven)
+	b, _, errB := big.ParseFloat(y.String(), 10, 256, big.ToNearestEven)
+
+	if errA != nil || errB != nil {
+		return strings.Compare(x.String(), y.String())
+	}
+
+	return a.Cmp(b)
+}
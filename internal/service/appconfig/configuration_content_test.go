@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig_test
+
+import (
+	"strings"
+	"testing"
+
+	tfappconfig "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+)
+
+func TestValidateJSONSchemaValidatorContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema  string
+		wantErr bool
+	}{
+		"valid": {
+			schema: `{"$schema": "http://json-schema.org/draft-04/schema#", "type": "object", "properties": {"port": {"type": "integer"}}}`,
+		},
+		"invalid type": {
+			schema:  `{"type": "integer-ish"}`,
+			wantErr: true,
+		},
+		"invalid JSON": {
+			schema:  `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfappconfig.ValidateJSONSchemaValidatorContent(testCase.schema)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestValidateConfigurationContentWithJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	const schema = `{
+  "$schema": "http://json-schema.org/draft-04/schema#",
+  "type": "object",
+  "properties": {
+    "host": {"type": "string"},
+    "port": {"type": "integer", "minimum": 1, "maximum": 65535}
+  },
+  "required": ["host"],
+  "additionalProperties": false
+}`
+
+	testCases := map[string]struct {
+		content  string
+		wantErrs []string
+	}{
+		"valid": {
+			content: `{"host": "example.com", "port": 443}`,
+		},
+		"missing property": {
+			content:  `{"port": 443}`,
+			wantErrs: []string{"host is required"},
+		},
+		"multiple errors": {
+			content:  `{"host": 1, "port": 70000, "extra": true}`,
+			wantErrs: []string{"host: Invalid type", "port: Must be less than or equal to 65535", "Additional property extra is not allowed"},
+		},
+		"invalid JSON": {
+			content:  `{"host":`,
+			wantErrs: []string{"invalid JSON"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfappconfig.ValidateConfigurationContentWithJSONSchema(schema, testCase.content)
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, want := range testCase.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateFeatureFlagsContent(t *testing.T) {
+	t.Parallel()
+
+	const flags = `
+  "flags": {
+    "checkout": {
+      "name": "Checkout",
+      "attributes": {
+        "color": {"constraints": {"type": "string", "pattern": "^[a-z]+$", "enum": ["red", "blue"]}},
+        "limit": {"constraints": {"type": "number", "minimum": 1, "maximum": 10, "required": true}},
+        "beta": {"constraints": {"type": "boolean"}},
+        "regions": {"constraints": {"type": "string[]", "elements": {"enum": ["us", "eu"]}}}
+      }
+    },
+    "dark_mode": {"name": "Dark mode"}
+  }`
+
+	testCases := map[string]struct {
+		content  string
+		wantErrs []string
+	}{
+		"valid": {
+			content: `{"version": "1",` + flags + `, "values": {
+  "checkout": {"enabled": true, "color": "red", "limit": 5, "beta": false, "regions": ["us"]},
+  "dark_mode": {"enabled": false, "_variants": []}
+}}`,
+		},
+		"no values": {
+			content: `{"version": "1",` + flags + `}`,
+		},
+		"invalid version": {
+			content:  `{"version": "2", "flags": {}}`,
+			wantErrs: []string{`version: must be "1", got "2"`},
+		},
+		"missing flags": {
+			content:  `{"version": "1"}`,
+			wantErrs: []string{"flags: required"},
+		},
+		"invalid flag definitions": {
+			content: `{"version": "1", "flags": {
+  "1bad": {"name": "Bad"},
+  "noname": {},
+  "typed": {"name": "Typed", "attributes": {
+    "a": {"constraints": {"type": "date"}},
+    "b": {"constraints": {"type": "number", "minimum": 10, "maximum": 1, "pattern": "x"}},
+    "c": {"constraints": {"type": "boolean", "enum": [true]}},
+    "d": {"constraints": {"type": "string", "enum": ["x", 1]}}
+  }}
+}}`,
+			wantErrs: []string{
+				"flags.1bad: invalid flag key",
+				"flags.noname.name: required",
+				`flags.typed.attributes.a.constraints.type: must be one of`,
+				"flags.typed.attributes.b.constraints: minimum (10) must not be greater than maximum (1)",
+				"flags.typed.attributes.b.constraints.pattern: only applies to string attributes",
+				"flags.typed.attributes.c.constraints: only the required constraint applies to boolean attributes",
+				"flags.typed.attributes.d.constraints.enum[1]: must be a string",
+			},
+		},
+		"invalid values": {
+			content: `{"version": "1",` + flags + `, "values": {
+  "checkout": {"enabled": "yes", "color": "green", "limit": 11, "beta": "no", "regions": ["ap", 1], "size": 1},
+  "dark_mode": {},
+  "missing": {"enabled": true}
+}}`,
+			wantErrs: []string{
+				"values.checkout.enabled: must be a boolean",
+				`values.checkout.color: "green" is not one of the allowed values`,
+				"values.checkout.limit: 11 is greater than the maximum (10)",
+				"values.checkout.beta: must be a boolean",
+				`values.checkout.regions[0]: "ap" is not one of the allowed values`,
+				"values.checkout.regions[1]: must be a string",
+				"values.checkout.size: undefined attribute",
+				"values.dark_mode.enabled: required",
+				"values.missing: undefined flag",
+			},
+		},
+		"missing required attribute": {
+			content:  `{"version": "1",` + flags + `, "values": {"checkout": {"enabled": true}}}`,
+			wantErrs: []string{"values.checkout.limit: required"},
+		},
+		"pattern mismatch": {
+			content:  `{"version": "1",` + flags + `, "values": {"checkout": {"enabled": true, "limit": 1, "color": "Red"}}}`,
+			wantErrs: []string{`values.checkout.color: "Red" does not match pattern`},
+		},
+		"unsupported pattern": {
+			content: `{"version": "1", "flags": {"f": {"name": "F", "attributes": {"a": {"constraints": {"type": "string", "pattern": "^(?!x)"}}}}},
+				"values": {"f": {"enabled": true, "a": "x"}}}`,
+		},
+		"invalid JSON": {
+			content:  `{"version": "1", "flags": []}`,
+			wantErrs: []string{"invalid JSON"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfappconfig.ValidateFeatureFlagsContent(testCase.content)
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, want := range testCase.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ package appconfig
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -54,6 +56,8 @@ func resourceConfigurationProfile() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceConfigurationProfileCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrApplicationID: {
 				Type:         schema.TypeString,
@@ -289,6 +293,28 @@ func resourceConfigurationProfileDelete(ctx context.Context, d *schema.ResourceD
 
 const configurationProfileResourceIDSeparator = ":"
 
+// resourceConfigurationProfileCustomizeDiff checks that the content of each JSON_SCHEMA validator is a valid JSON Schema.
+func resourceConfigurationProfileCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("validator") || (d.Id() != "" && !d.HasChange("validator")) {
+		return nil
+	}
+
+	var errs []error
+
+	for _, validator := range expandValidators(d.Get("validator").(*schema.Set).List()) {
+		// Invalid JSON, including unknown values, is reported by the content attribute's ValidateFunc.
+		if validator.Type != awstypes.ValidatorTypeJsonSchema || !json.Valid([]byte(aws.ToString(validator.Content))) {
+			continue
+		}
+
+		if err := validateJSONSchemaValidatorContent(aws.ToString(validator.Content)); err != nil {
+			errs = append(errs, fmt.Errorf("validator: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func configurationProfileCreateResourceID(configurationProfileID, applicationID string) string {
 	parts := []string{configurationProfileID, applicationID}
 	id := strings.Join(parts, configurationProfileResourceIDSeparator)
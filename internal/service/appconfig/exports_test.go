@@ -22,4 +22,8 @@ var (
 	FindExtensionByID                            = findExtensionByID
 	FindExtensionAssociationByID                 = findExtensionAssociationByID
 	FindHostedConfigurationVersionByThreePartKey = findHostedConfigurationVersionByThreePartKey
+
+	ValidateConfigurationContentWithJSONSchema = validateConfigurationContentWithJSONSchema
+	ValidateFeatureFlagsContent                = validateFeatureFlagsContent
+	ValidateJSONSchemaValidatorContent         = validateJSONSchemaValidatorContent
 )
@@ -16,12 +16,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -30,12 +33,15 @@ func resourceHostedConfigurationVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostedConfigurationVersionCreate,
 		ReadWithoutTimeout:   resourceHostedConfigurationVersionRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow configuration_profile_type and validation_schema update.
 		DeleteWithoutTimeout: resourceHostedConfigurationVersionDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceHostedConfigurationVersionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrApplicationID: {
 				Type:         schema.TypeString,
@@ -53,6 +59,11 @@ func resourceHostedConfigurationVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
 			},
+			"configuration_profile_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[configurationProfileType](),
+			},
 			names.AttrContent: {
 				Type:      schema.TypeString,
 				Required:  true,
@@ -71,6 +82,17 @@ func resourceHostedConfigurationVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"validation_schema": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 			"version_number": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -169,6 +191,39 @@ func resourceHostedConfigurationVersionDelete(ctx context.Context, d *schema.Res
 
 const hostedConfigurationVersionResourceIDSeparator = "/"
 
+// resourceHostedConfigurationVersionCustomizeDiff validates new configuration content against the configured validation settings:
+// content for a feature flags configuration profile is type checked, and other JSON content is validated against the JSON Schema.
+// Only configured values are used, as the configuration profile may be created or updated in the same apply.
+func resourceHostedConfigurationVersionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() != "" && !d.HasChanges(names.AttrContent, names.AttrContentType) {
+		return nil
+	}
+
+	for _, key := range []string{names.AttrContent, names.AttrContentType, "configuration_profile_type", "validation_schema"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	content, contentType := d.Get(names.AttrContent).(string), d.Get(names.AttrContentType).(string)
+
+	if configurationProfileType(d.Get("configuration_profile_type").(string)) == configurationProfileTypeFeatureFlags {
+		if err := validateFeatureFlagsContent(content); err != nil {
+			return fmt.Errorf("content is not valid %s configuration data:\n%w", configurationProfileTypeFeatureFlags, err)
+		}
+
+		return nil
+	}
+
+	if v, ok := d.GetOk("validation_schema"); ok && isJSONContentType(contentType) {
+		if err := validateConfigurationContentWithJSONSchema(v.(string), content); err != nil {
+			return fmt.Errorf("content does not match validation_schema:\n%w", err)
+		}
+	}
+
+	return nil
+}
+
 func hostedConfigurationVersionCreateResourceID(applicationID, configurationProfileID string, versionNumber int32) string {
 	parts := []string{applicationID, configurationProfileID, flex.Int32ValueToStringValue(versionNumber)}
 	id := strings.Join(parts, hostedConfigurationVersionResourceIDSeparator)
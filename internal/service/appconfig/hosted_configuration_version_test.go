@@ -76,6 +76,42 @@ func TestAccAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigHostedConfigurationVersion_jsonSchemaValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHostedConfigurationVersionConfig_jsonSchemaValidation(rName),
+				ExpectError: regexache.MustCompile(`content does not match validation_schema`),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigHostedConfigurationVersion_featureFlagsValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHostedConfigurationVersionConfig_featureFlagsValidation(rName),
+				ExpectError: regexache.MustCompile(`values.flag1.attr1: 11 is greater than the maximum \(10\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckHostedConfigurationVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
@@ -134,3 +170,93 @@ resource "aws_appconfig_hosted_configuration_version" "test" {
 }
 `, rName))
 }
+
+func testAccHostedConfigurationVersionConfig_jsonSchemaValidation(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_name(rName),
+		fmt.Sprintf(`
+locals {
+  validation_schema = jsonencode({
+    "$schema"            = "http://json-schema.org/draft-04/schema#"
+    type                 = "object"
+    additionalProperties = false
+    patternProperties = {
+      "[^\\s]+$" = {
+        type = "boolean"
+      }
+    }
+  })
+}
+
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+
+  validator {
+    content = local.validation_schema
+    type    = "JSON_SCHEMA"
+  }
+}
+
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+  validation_schema        = local.validation_schema
+
+  content = jsonencode({
+    foo = "bar"
+  })
+}
+`, rName))
+}
+
+func testAccHostedConfigurationVersionConfig_featureFlagsProfile(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_name(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+  type           = "AWS.AppConfig.FeatureFlags"
+}
+`, rName))
+}
+
+func testAccHostedConfigurationVersionConfig_featureFlagsValidation(rName string) string {
+	return acctest.ConfigCompose(
+		testAccHostedConfigurationVersionConfig_featureFlagsProfile(rName),
+		`
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id   = aws_appconfig_configuration_profile.test.configuration_profile_id
+  configuration_profile_type = aws_appconfig_configuration_profile.test.type
+  content_type               = "application/json"
+
+  content = jsonencode({
+    flags = {
+      flag1 = {
+        name = "flag1"
+        attributes = {
+          attr1 = {
+            constraints = {
+              type    = "number"
+              maximum = 10
+            }
+          }
+        }
+      }
+    }
+    values = {
+      flag1 = {
+        enabled = true
+        attr1   = 11
+      }
+    }
+    version = "1"
+  })
+}
+`)
+}
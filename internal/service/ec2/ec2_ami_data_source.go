@@ -20,7 +20,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -233,6 +235,16 @@ func dataSourceAMI() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"version_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidVersionConstraint,
+			},
+			"version_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -289,6 +301,25 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		filteredImages = images[:]
 	}
 
+	if versionRegex, versionConstraint := d.Get("version_regex").(string), d.Get("version_constraint").(string); versionRegex != "" || versionConstraint != "" {
+		selector, err := semver.NewSelector(versionRegex, versionConstraint)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// Keep only the images with the highest version extracted from their names.
+		filteredImages = semver.Select(selector, filteredImages, func(v awstypes.Image) string {
+			return aws.ToString(v.Name)
+		})
+		if n := len(filteredImages); n > 1 {
+			highest, _ := selector.Version(aws.ToString(filteredImages[n-1].Name))
+			filteredImages = slices.DeleteFunc(filteredImages, func(v awstypes.Image) bool {
+				version, _ := selector.Version(aws.ToString(v.Name))
+				return !version.Equal(highest)
+			})
+		}
+	}
+
 	if len(filteredImages) < 1 {
 		return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again.")
 	}
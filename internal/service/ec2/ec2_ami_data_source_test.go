@@ -272,6 +272,30 @@ func TestAccEC2AMIDataSource_localNameFilter(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIDataSource_versionConstraint(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_ami.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIDataSourceConfig_versionConstraint,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(datasourceName, "image_id", regexache.MustCompile("^ami-")),
+					resource.TestMatchResourceAttr(datasourceName, names.AttrName, regexache.MustCompile(`-kernel-6\.1-x86_64$`)),
+				),
+			},
+			{
+				Config:      testAccAMIDataSourceConfig_versionConstraintInvalid,
+				ExpectError: regexache.MustCompile(`parsing version constraint`),
+			},
+		},
+	})
+}
+
 func TestAccEC2AMIDataSource_gp3BlockDevice(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ami.test"
@@ -437,6 +461,36 @@ data "aws_ami" "test" {
 }
 `
 
+// Testing version_regex and version_constraint parameters
+const testAccAMIDataSourceConfig_versionConstraint = `
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["al2023-ami-2023.*-x86_64"]
+  }
+
+  version_regex      = "-kernel-(\\d+\\.\\d+)-"
+  version_constraint = "~> 6.1.0"
+}
+`
+
+const testAccAMIDataSourceConfig_versionConstraintInvalid = `
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["al2023-ami-2023.*-x86_64"]
+  }
+
+  version_constraint = "not a constraint"
+}
+`
+
 func testAccAMIDataSourceConfig_gp3BlockDevice(rName string) string {
 	return acctest.ConfigCompose(
 		testAccAMIConfig_gp3BlockDevice(rName),
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// userDataMaxSize is the maximum size of instance user data, before base64 encoding.
+	userDataMaxSize = 16384
+
+	userDataDefaultBoundary = "MIMEBOUNDARY"
+)
+
+const (
+	userDataContentTypeCloudBoothook = "text/cloud-boothook"
+	userDataContentTypeCloudConfig   = "text/cloud-config"
+	userDataContentTypeShellScript   = "text/x-shellscript"
+)
+
+func userDataContentType_Values() []string {
+	return []string{
+		userDataContentTypeCloudBoothook,
+		userDataContentTypeCloudConfig,
+		userDataContentTypeShellScript,
+	}
+}
+
+type userDataPart struct {
+	content     string
+	contentType string
+	filename    string
+}
+
+// buildUserData builds a MIME multipart cloud-init document from parts.
+// All cloud-config parts are merged into a single cloud-config part, at the position of the first one:
+// maps are merged recursively, lists are appended and other values are replaced by later parts.
+// Other parts are included in order.
+func buildUserData(parts []userDataPart, boundary string) (string, error) {
+	var (
+		cloudConfig    map[string]any
+		cloudConfigIdx = -1
+		mimeParts      []userDataPart
+	)
+
+	for i, part := range parts {
+		if part.contentType != userDataContentTypeCloudConfig {
+			mimeParts = append(mimeParts, part)
+			continue
+		}
+
+		var v map[string]any
+		if err := yaml.Unmarshal([]byte(part.content), &v); err != nil {
+			return "", fmt.Errorf("part %d: parsing cloud-config: %w", i, err)
+		}
+
+		if cloudConfigIdx == -1 {
+			cloudConfigIdx = len(mimeParts)
+			mimeParts = append(mimeParts, userDataPart{
+				contentType: userDataContentTypeCloudConfig,
+				filename:    part.filename,
+			})
+		}
+		cloudConfig = mergeCloudConfig(cloudConfig, v)
+	}
+
+	if cloudConfigIdx != -1 {
+		if cloudConfig == nil {
+			cloudConfig = make(map[string]any)
+		}
+
+		var b strings.Builder
+		b.WriteString("#cloud-config\n")
+
+		enc := yaml.NewEncoder(&b)
+		enc.SetIndent(2)
+		if err := enc.Encode(cloudConfig); err != nil {
+			return "", fmt.Errorf("encoding cloud-config: %w", err)
+		}
+		if err := enc.Close(); err != nil {
+			return "", fmt.Errorf("encoding cloud-config: %w", err)
+		}
+
+		mimeParts[cloudConfigIdx].content = b.String()
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", boundary)
+	buf.WriteString("MIME-Version: 1.0\r\n\r\n")
+
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(boundary); err != nil {
+		return "", err
+	}
+
+	for _, part := range mimeParts {
+		if strings.Contains(part.content, "--"+boundary) {
+			return "", fmt.Errorf("%s part contains the MIME boundary %q", part.contentType, boundary)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Content-Type", part.contentType)
+		header.Set("Mime-Version", "1.0")
+		if part.filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.filename))
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := pw.Write([]byte(part.content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// mergeCloudConfig merges src into dst and returns the result.
+func mergeCloudConfig(dst, src map[string]any) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any, len(src))
+	}
+
+	for k, v := range src {
+		dst[k] = mergeCloudConfigValue(dst[k], v)
+	}
+
+	return dst
+}
+
+func mergeCloudConfigValue(dst, src any) any {
+	switch src := src.(type) {
+	case map[string]any:
+		if dst, ok := dst.(map[string]any); ok {
+			return mergeCloudConfig(dst, src)
+		}
+	case []any:
+		if dst, ok := dst.([]any); ok {
+			return append(dst, src...)
+		}
+	}
+
+	return src
+}
+
+// gzipUserData compresses user data. The output is deterministic for a given input.
+func gzipUserData(userData string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write([]byte(userData)); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
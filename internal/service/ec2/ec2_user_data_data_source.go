@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ec2_user_data", name="User Data")
+// @Region(overrideEnabled=false)
+func newUserDataDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &userDataDataSource{}, nil
+}
+
+type userDataDataSource struct {
+	framework.DataSourceWithModel[userDataDataSourceModel]
+}
+
+func (d *userDataDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"boundary": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 70),
+				},
+			},
+			"gzip": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"size": schema.Int64Attribute{
+				Computed: true,
+			},
+			"size_limit": schema.Int64Attribute{
+				Computed: true,
+			},
+			"user_data": schema.StringAttribute{
+				Computed: true,
+			},
+			"user_data_base64": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"part": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[userDataPartModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrContent: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrContentType: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(userDataContentType_Values()...),
+							},
+						},
+						"filename": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *userDataDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data userDataDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Boundary.IsNull() || data.Boundary.IsUnknown() {
+		data.Boundary = types.StringValue(userDataDefaultBoundary)
+	}
+	if data.Gzip.IsNull() || data.Gzip.IsUnknown() {
+		data.Gzip = types.BoolValue(false)
+	}
+
+	partsData, diags := data.Parts.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts := make([]userDataPart, 0, len(partsData))
+	for _, v := range partsData {
+		parts = append(parts, userDataPart{
+			content:     v.Content.ValueString(),
+			contentType: v.ContentType.ValueString(),
+			filename:    v.Filename.ValueString(),
+		})
+	}
+
+	userData, err := buildUserData(parts, data.Boundary.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("part"), "Invalid user data part", err.Error())
+
+		return
+	}
+
+	encoded := []byte(userData)
+	if data.Gzip.ValueBool() {
+		if encoded, err = gzipUserData(userData); err != nil {
+			response.Diagnostics.AddError("compressing user data", err.Error())
+
+			return
+		}
+	}
+
+	if size := len(encoded); size > userDataMaxSize {
+		response.Diagnostics.AddWarning(
+			"EC2 user data size limit exceeded",
+			fmt.Sprintf("The user data (%d bytes) exceeds the EC2 user data size limit (%d bytes).", size, userDataMaxSize),
+		)
+	}
+
+	data.Size = types.Int64Value(int64(len(encoded)))
+	data.SizeLimit = types.Int64Value(userDataMaxSize)
+	data.UserData = types.StringValue(userData)
+	data.UserDataBase64 = types.StringValue(base64.StdEncoding.EncodeToString(encoded))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type userDataDataSourceModel struct {
+	Boundary       types.String                                       `tfsdk:"boundary"`
+	Gzip           types.Bool                                         `tfsdk:"gzip"`
+	Parts          fwtypes.ListNestedObjectValueOf[userDataPartModel] `tfsdk:"part"`
+	Size           types.Int64                                        `tfsdk:"size"`
+	SizeLimit      types.Int64                                        `tfsdk:"size_limit"`
+	UserData       types.String                                       `tfsdk:"user_data"`
+	UserDataBase64 types.String                                       `tfsdk:"user_data_base64"`
+}
+
+type userDataPartModel struct {
+	Content     types.String `tfsdk:"content"`
+	ContentType types.String `tfsdk:"content_type"`
+	Filename    types.String `tfsdk:"filename"`
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2UserDataDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_user_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "boundary", "MIMEBOUNDARY"),
+					resource.TestCheckResourceAttr(dataSourceName, "gzip", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "size_limit", "16384"),
+					resource.TestMatchResourceAttr(dataSourceName, "user_data", regexache.MustCompile(`(?s)Content-Type: text/cloud-config.*packages:\n  - nginx\n  - jq\n.*Content-Type: text/x-shellscript.*echo hello`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "user_data_base64"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "size"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2UserDataDataSource_gzip(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_user_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataDataSourceConfig_gzip,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "gzip", acctest.CtTrue),
+					// The gzip magic number, base64 encoded.
+					resource.TestMatchResourceAttr(dataSourceName, "user_data_base64", regexache.MustCompile(`^H4sI`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2UserDataDataSource_invalidCloudConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserDataDataSourceConfig_invalidCloudConfig,
+				ExpectError: regexache.MustCompile(`parsing cloud-config`),
+			},
+		},
+	})
+}
+
+const testAccUserDataDataSourceConfig_basic = `
+data "aws_ec2_user_data" "test" {
+  part {
+    content_type = "text/cloud-config"
+    content = yamlencode({
+      packages = ["nginx"]
+    })
+  }
+
+  part {
+    content_type = "text/x-shellscript"
+    content      = "#!/bin/bash\necho hello\n"
+  }
+
+  part {
+    content_type = "text/cloud-config"
+    content = yamlencode({
+      packages = ["jq"]
+    })
+  }
+}
+`
+
+const testAccUserDataDataSourceConfig_gzip = `
+data "aws_ec2_user_data" "test" {
+  gzip = true
+
+  part {
+    content_type = "text/x-shellscript"
+    content      = "#!/bin/bash\necho hello\n"
+  }
+}
+`
+
+const testAccUserDataDataSourceConfig_invalidCloudConfig = `
+data "aws_ec2_user_data" "test" {
+  part {
+    content_type = "text/cloud-config"
+    content      = "- not\n- a\n- map\n"
+  }
+}
+`
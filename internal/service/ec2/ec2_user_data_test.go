@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildUserData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parts   []userDataPart
+		want    []userDataPart
+		wantErr string
+	}{
+		"shell script": {
+			parts: []userDataPart{
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/bash\necho hello\n", filename: "hello.sh"},
+			},
+			want: []userDataPart{
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/bash\necho hello\n", filename: "hello.sh"},
+			},
+		},
+		"parts in order": {
+			parts: []userDataPart{
+				{contentType: userDataContentTypeCloudBoothook, content: "#cloud-boothook\necho boot\n"},
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho one\n"},
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho two\n"},
+			},
+			want: []userDataPart{
+				{contentType: userDataContentTypeCloudBoothook, content: "#cloud-boothook\necho boot\n"},
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho one\n"},
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho two\n"},
+			},
+		},
+		"cloud-config merged": {
+			parts: []userDataPart{
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho one\n"},
+				{contentType: userDataContentTypeCloudConfig, content: "#cloud-config\npackages:\n  - nginx\nwrite_files:\n  - path: /etc/a\n    content: a\ntimezone: UTC\n"},
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho two\n"},
+				{contentType: userDataContentTypeCloudConfig, content: "#cloud-config\npackages:\n  - jq\ntimezone: Europe/London\nntp:\n  enabled: true\n"},
+				{contentType: userDataContentTypeCloudConfig, content: "#cloud-config\nntp:\n  servers:\n    - 169.254.169.123\n"},
+			},
+			want: []userDataPart{
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho one\n"},
+				{contentType: userDataContentTypeCloudConfig, content: `#cloud-config
+ntp:
+  enabled: true
+  servers:
+    - 169.254.169.123
+packages:
+  - nginx
+  - jq
+timezone: Europe/London
+write_files:
+  - content: a
+    path: /etc/a
+`},
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho two\n"},
+			},
+		},
+		"invalid cloud-config": {
+			parts: []userDataPart{
+				{contentType: userDataContentTypeCloudConfig, content: "#cloud-config\n- a\n- b\n"},
+			},
+			wantErr: "parsing cloud-config",
+		},
+		"boundary in content": {
+			parts: []userDataPart{
+				{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho --MIMEBOUNDARY\n"},
+			},
+			wantErr: "contains the MIME boundary",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			userData, err := buildUserData(testCase.parts, userDataDefaultBoundary)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := parseTestUserData(t, userData)
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(userDataPart{})); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildUserData_deterministic(t *testing.T) {
+	t.Parallel()
+
+	parts := []userDataPart{
+		{contentType: userDataContentTypeCloudConfig, content: "#cloud-config\na: 1\nb: 2\nc: 3\nd: 4\n"},
+		{contentType: userDataContentTypeShellScript, content: "#!/bin/sh\necho hello\n"},
+	}
+
+	want, err := buildUserData(parts, userDataDefaultBoundary)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	wantGzip, err := gzipUserData(want)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for range 10 {
+		got, err := buildUserData(parts, userDataDefaultBoundary)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+
+		gotGzip, err := gzipUserData(got)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !bytes.Equal(gotGzip, wantGzip) {
+			t.Fatal("compressed user data differs")
+		}
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(wantGzip))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := string(b); got != want {
+		t.Errorf("decompressed %q, want %q", got, want)
+	}
+}
+
+func parseTestUserData(t *testing.T, userData string) []userDataPart {
+	t.Helper()
+
+	header, body, ok := strings.Cut(userData, "\r\n\r\n")
+	if !ok {
+		t.Fatalf("no header in %q", userData)
+	}
+
+	contentType, _ := strings.CutPrefix(strings.Split(header, "\r\n")[0], "Content-Type: ")
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("parsing Content-Type: %s", err)
+	}
+	if mediaType != "multipart/mixed" {
+		t.Fatalf("Content-Type = %s, want multipart/mixed", mediaType)
+	}
+
+	var parts []userDataPart
+	r := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	for {
+		p, err := r.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("reading part: %s", err)
+		}
+
+		b, err := io.ReadAll(p)
+		if err != nil {
+			t.Fatalf("reading part: %s", err)
+		}
+
+		parts = append(parts, userDataPart{
+			content:     string(b),
+			contentType: p.Header.Get("Content-Type"),
+			filename:    p.FileName(),
+		})
+	}
+
+	return parts
+}
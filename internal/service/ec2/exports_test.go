@@ -206,6 +206,7 @@ var (
 	FindSecurityGroupByID                                      = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                            = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
+	FindSecurityGroupRulesExclusiveBySecurityGroupID           = findSecurityGroupRulesExclusiveBySecurityGroupID
 	FindSecurityGroupVPCAssociationByTwoPartKey                = findSecurityGroupVPCAssociationByTwoPartKey
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
@@ -266,6 +267,7 @@ var (
 	InstanceMigrateState                                       = instanceMigrateState
 	InstanceStateUpgradeV1                                     = instanceStateUpgradeV1
 	InternetGatewayAttachmentParseResourceID                   = internetGatewayAttachmentParseResourceID
+	IsDefaultEgressSecurityGroupRule                           = isDefaultEgressSecurityGroupRule
 	KeyPairMigrateState                                        = keyPairMigrateState
 	ManagedPrefixListEntryCreateResourceID                     = managedPrefixListEntryCreateResourceID
 	ManagedPrefixListEntryParseResourceID                      = managedPrefixListEntryParseResourceID
@@ -285,6 +287,7 @@ var (
 	SecurityGroupRuleCreateID                                  = securityGroupRuleCreateID
 	SecurityGroupRuleHash                                      = securityGroupRuleHash
 	SecurityGroupRuleMigrateState                              = securityGroupRuleMigrateState
+	SecurityGroupRuleSummary                                   = securityGroupRuleSummary
 	SpotFleetRequestMigrateState                               = spotFleetRequestMigrateState
 	StopEBSVolumeAttachmentInstance                            = stopVolumeAttachmentInstance
 	StopInstance                                               = stopInstance
@@ -471,27 +471,28 @@ func findInstance(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInst
 }
 
 func findInstanceByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.Instance, error) {
-	input := ec2.DescribeInstancesInput{
-		InstanceIds: []string{id},
-	}
-
-	output, err := findInstance(ctx, conn, &input)
+	// Concurrent lookups are coalesced into a single DescribeInstances call.
+	output, err := instanceBatcher.find(ctx, conn, id)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if output.State == nil {
+		return nil, tfresource.NewEmptyResultError(id)
+	}
+
 	if state := output.State.Name; state == awstypes.InstanceStateNameTerminated {
 		return nil, &retry.NotFoundError{
 			Message:     string(state),
-			LastRequest: &input,
+			LastRequest: id,
 		}
 	}
 
 	// Eventual consistency check.
 	if aws.ToString(output.InstanceId) != id {
 		return nil, &retry.NotFoundError{
-			LastRequest: &input,
+			LastRequest: id,
 		}
 	}
 
@@ -1306,11 +1307,8 @@ func findSpotPrice(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSpo
 }
 
 func findSubnetByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.Subnet, error) {
-	input := ec2.DescribeSubnetsInput{
-		SubnetIds: []string{id},
-	}
-
-	output, err := findSubnet(ctx, conn, &input)
+	// Concurrent lookups are coalesced into a single DescribeSubnets call.
+	output, err := subnetBatcher.find(ctx, conn, id)
 
 	if err != nil {
 		return nil, err
@@ -1319,7 +1317,7 @@ func findSubnetByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes
 	// Eventual consistency check.
 	if aws.ToString(output.SubnetId) != id {
 		return nil, &retry.NotFoundError{
-			LastRequest: &input,
+			LastRequest: id,
 		}
 	}
 
@@ -2334,11 +2332,8 @@ func findEBSVolume(ctx context.Context, conn *ec2.Client, input *ec2.DescribeVol
 }
 
 func findEBSVolumeByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.Volume, error) {
-	input := ec2.DescribeVolumesInput{
-		VolumeIds: []string{id},
-	}
-
-	output, err := findEBSVolume(ctx, conn, &input)
+	// Concurrent lookups are coalesced into a single DescribeVolumes call.
+	output, err := ebsVolumeBatcher.find(ctx, conn, id)
 
 	if err != nil {
 		return nil, err
@@ -2347,14 +2342,14 @@ func findEBSVolumeByID(ctx context.Context, conn *ec2.Client, id string) (*awsty
 	if state := output.State; state == awstypes.VolumeStateDeleted {
 		return nil, &retry.NotFoundError{
 			Message:     string(state),
-			LastRequest: &input,
+			LastRequest: id,
 		}
 	}
 
 	// Eventual consistency check.
 	if aws.ToString(output.VolumeId) != id {
 		return nil, &retry.NotFoundError{
-			LastRequest: &input,
+			LastRequest: id,
 		}
 	}
 
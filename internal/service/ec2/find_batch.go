@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// describeBatchWindow is how long a batch stays open for further IDs after the first ID is added.
+	describeBatchWindow = 50 * time.Millisecond
+	// describeBatchMaxIDs is the maximum number of IDs sent in a single Describe call.
+	// EC2's Describe calls accept up to 1,000 IDs, but IDs are sent as the values of a single filter,
+	// which EC2 limits to 200 values.
+	describeBatchMaxIDs = 200
+)
+
+var (
+	instanceBatcher = newDescribeBatcher(func(ctx context.Context, conn *ec2.Client, ids []string) ([]awstypes.Instance, error) {
+		input := ec2.DescribeInstancesInput{
+			Filters: []awstypes.Filter{newFilter("instance-id", ids)},
+		}
+
+		return findInstances(ctx, conn, &input)
+	}, func(v *awstypes.Instance) string {
+		return aws.ToString(v.InstanceId)
+	})
+
+	ebsVolumeBatcher = newDescribeBatcher(func(ctx context.Context, conn *ec2.Client, ids []string) ([]awstypes.Volume, error) {
+		input := ec2.DescribeVolumesInput{
+			Filters: []awstypes.Filter{newFilter("volume-id", ids)},
+		}
+
+		return findEBSVolumes(ctx, conn, &input)
+	}, func(v *awstypes.Volume) string {
+		return aws.ToString(v.VolumeId)
+	})
+
+	subnetBatcher = newDescribeBatcher(func(ctx context.Context, conn *ec2.Client, ids []string) ([]awstypes.Subnet, error) {
+		input := ec2.DescribeSubnetsInput{
+			Filters: []awstypes.Filter{newFilter("subnet-id", ids)},
+		}
+
+		return findSubnets(ctx, conn, &input)
+	}, func(v *awstypes.Subnet) string {
+		return aws.ToString(v.SubnetId)
+	})
+)
+
+// describeBatcher coalesces concurrent lookups of single resources by ID into one Describe call.
+//
+// A lookup made while no other lookup is waiting, e.g. a waiter's status poll, makes its Describe call straight away.
+// Otherwise the lookup opens a batch which stays open for describeBatchWindow, or until it holds describeBatchMaxIDs IDs.
+// The batch's Describe call is then made and its results are fanned back out to each caller.
+// IDs are passed as filter values rather than as the Describe call's IDs, as EC2 fails the whole call if any one
+// of the requested IDs does not exist, whereas a filter returns whichever of the resources exist.
+// Batches are keyed by API client. The provider caches one client per Region, so a batch never spans
+// provider instances or Regions, and the per-resource `region` override is honored.
+// Open batches are the only state held; nothing is cached once a batch's results have been delivered.
+type describeBatcher[T any] struct {
+	describe func(context.Context, *ec2.Client, []string) ([]T, error)
+	id       func(*T) string
+	window   time.Duration
+	maxIDs   int
+
+	mu      sync.Mutex
+	batches map[*ec2.Client]*describeBatch[T] // Open batches.
+	pending map[*ec2.Client]int               // Number of open or running batches.
+}
+
+type describeBatch[T any] struct {
+	ids  []string
+	once sync.Once
+	done chan struct{}
+
+	results map[string]*T
+	err     error
+}
+
+func newDescribeBatcher[T any](describe func(context.Context, *ec2.Client, []string) ([]T, error), id func(*T) string) *describeBatcher[T] {
+	return &describeBatcher[T]{
+		describe: describe,
+		id:       id,
+		window:   describeBatchWindow,
+		maxIDs:   describeBatchMaxIDs,
+		batches:  make(map[*ec2.Client]*describeBatch[T]),
+		pending:  make(map[*ec2.Client]int),
+	}
+}
+
+// find returns the resource with the specified ID, or a NotFoundError if there is none.
+func (b *describeBatcher[T]) find(ctx context.Context, conn *ec2.Client, id string) (*T, error) {
+	// The batch outlives any one caller, so it must not be canceled along with the caller that opened it.
+	batchCtx := context.WithoutCancel(ctx)
+
+	b.mu.Lock()
+	batch, ok := b.batches[conn]
+	if !ok {
+		batch = &describeBatch[T]{
+			done: make(chan struct{}),
+		}
+
+		// If no other lookup is waiting there is nothing to wait for, so the batch is run straight away.
+		if b.pending[conn] > 0 {
+			b.batches[conn] = batch
+
+			time.AfterFunc(b.window, func() {
+				b.mu.Lock()
+				b.close(conn, batch)
+				b.mu.Unlock()
+
+				b.run(batchCtx, conn, batch)
+			})
+		}
+		b.pending[conn]++
+	}
+	if !slices.Contains(batch.ids, id) {
+		batch.ids = append(batch.ids, id)
+	}
+	ready := b.batches[conn] != batch || len(batch.ids) >= b.maxIDs
+	if ready {
+		b.close(conn, batch)
+	}
+	b.mu.Unlock()
+
+	if ready {
+		b.run(batchCtx, conn, batch)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-batch.done:
+	}
+
+	if batch.err != nil {
+		return nil, batch.err
+	}
+
+	if v, ok := batch.results[id]; ok {
+		return v, nil
+	}
+
+	return nil, tfresource.NewEmptyResultError(id)
+}
+
+// close stops further IDs being added to the batch. The caller must hold the lock.
+func (b *describeBatcher[T]) close(conn *ec2.Client, batch *describeBatch[T]) {
+	if b.batches[conn] == batch {
+		delete(b.batches, conn)
+	}
+}
+
+// run makes the batch's Describe call, once.
+func (b *describeBatcher[T]) run(ctx context.Context, conn *ec2.Client, batch *describeBatch[T]) {
+	batch.once.Do(func() {
+		defer close(batch.done)
+		defer func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if b.pending[conn]--; b.pending[conn] == 0 {
+				delete(b.pending, conn)
+			}
+		}()
+
+		// The batch is closed, so its IDs can be read without holding the lock.
+		ids := batch.ids
+		if len(ids) > 1 {
+			tflog.Debug(ctx, "Describing EC2 resources in batch", map[string]any{
+				"count": len(ids),
+			})
+		}
+
+		output, err := b.describe(ctx, conn, ids)
+
+		if err != nil {
+			batch.err = err
+
+			return
+		}
+
+		batch.results = make(map[string]*T, len(output))
+		for i := range output {
+			batch.results[b.id(&output[i])] = &output[i]
+		}
+	})
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testDescribeBatcherCalls struct {
+	mu    sync.Mutex
+	calls [][]string
+
+	// Calls for the "blocking" ID wait until release is closed.
+	blocking sync.WaitGroup
+	release  chan struct{}
+}
+
+func (c *testDescribeBatcherCalls) describe(_ context.Context, _ *ec2.Client, ids []string) ([]string, error) {
+	c.mu.Lock()
+	c.calls = append(c.calls, slices.Clone(ids))
+	c.mu.Unlock()
+
+	if slices.Contains(ids, "blocking") {
+		c.blocking.Done()
+		<-c.release
+	}
+
+	// Like a Describe call filtered by ID, resources that don't exist are omitted.
+	var output []string
+	for _, id := range ids {
+		switch id {
+		case "missing":
+		case "failing":
+			return nil, errors.New("failed")
+		default:
+			output = append(output, id)
+		}
+	}
+
+	return output, nil
+}
+
+// startBlocking starts a lookup whose Describe call is in flight until the returned function is called,
+// so that further lookups for the same client are batched.
+func (c *testDescribeBatcherCalls) startBlocking(ctx context.Context, t *testing.T, b *describeBatcher[string], conn *ec2.Client) func() {
+	t.Helper()
+
+	c.blocking.Add(1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		if _, err := b.find(ctx, conn, "blocking"); err != nil {
+			t.Errorf("find(blocking): %s", err)
+		}
+	}()
+	c.blocking.Wait()
+
+	return func() {
+		close(c.release)
+		<-done
+	}
+}
+
+func newTestDescribeBatcher(window time.Duration, maxIDs int) (*describeBatcher[string], *testDescribeBatcherCalls) {
+	calls := &testDescribeBatcherCalls{
+		release: make(chan struct{}),
+	}
+	b := newDescribeBatcher(calls.describe, func(v *string) string { return *v })
+	b.window = window
+	b.maxIDs = maxIDs
+
+	return b, calls
+}
+
+func findConcurrently(ctx context.Context, b *describeBatcher[string], conn *ec2.Client, ids []string) []error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(ids))
+
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			v, err := b.find(ctx, conn, id)
+			if err == nil && *v != id {
+				err = fmt.Errorf("got %s, want %s", *v, id)
+			}
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func TestDescribeBatcher_coalesces(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, calls := newTestDescribeBatcher(100*time.Millisecond, 1000)
+	conn := &ec2.Client{}
+	ids := []string{"a", "b", "c", "b"}
+
+	release := calls.startBlocking(ctx, t, b, conn)
+	for i, err := range findConcurrently(ctx, b, conn, ids) {
+		if err != nil {
+			t.Errorf("find(%s): %s", ids[i], err)
+		}
+	}
+	release()
+
+	if got, want := len(calls.calls), 2; got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+	if got, want := len(calls.calls[1]), 3; got != want {
+		t.Errorf("IDs in batched call = %d, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcher_immediate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	b, calls := newTestDescribeBatcher(time.Hour, 1000)
+	conn := &ec2.Client{}
+
+	// Lookups made while no other lookup is waiting don't wait for the batch window.
+	for _, id := range []string{"a", "b"} {
+		if _, err := b.find(ctx, conn, id); err != nil {
+			t.Fatalf("find(%s): %s", id, err)
+		}
+	}
+
+	if got, want := len(calls.calls), 2; got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcher_maxIDs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, calls := newTestDescribeBatcher(time.Hour, 2)
+	conn := &ec2.Client{}
+	ids := []string{"a", "b", "c", "d"}
+
+	release := calls.startBlocking(ctx, t, b, conn)
+	for i, err := range findConcurrently(ctx, b, conn, ids) {
+		if err != nil {
+			t.Errorf("find(%s): %s", ids[i], err)
+		}
+	}
+	release()
+
+	if got, want := len(calls.calls), 3; got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcher_perClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, calls := newTestDescribeBatcher(100*time.Millisecond, 1000)
+	conn1, conn2 := &ec2.Client{}, &ec2.Client{}
+
+	var wg sync.WaitGroup
+	calls.blocking.Add(2)
+	for _, conn := range []*ec2.Client{conn1, conn2} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := b.find(ctx, conn, "blocking"); err != nil {
+				t.Errorf("find(blocking): %s", err)
+			}
+		}()
+	}
+	calls.blocking.Wait()
+
+	var batchWG sync.WaitGroup
+	for _, conn := range []*ec2.Client{conn1, conn2} {
+		batchWG.Add(1)
+		go func() {
+			defer batchWG.Done()
+
+			for _, err := range findConcurrently(ctx, b, conn, []string{"a", "b"}) {
+				if err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	batchWG.Wait()
+	close(calls.release)
+	wg.Wait()
+
+	if got, want := len(calls.calls), 4; got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+	for _, ids := range calls.calls[2:] {
+		if got, want := len(ids), 2; got != want {
+			t.Errorf("IDs in batched call = %d, want %d", got, want)
+		}
+	}
+}
+
+func TestDescribeBatcher_partialResults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, calls := newTestDescribeBatcher(100*time.Millisecond, 1000)
+	conn := &ec2.Client{}
+	ids := []string{"a", "missing", "b"}
+
+	release := calls.startBlocking(ctx, t, b, conn)
+	errs := findConcurrently(ctx, b, conn, ids)
+	release()
+
+	for i, err := range errs {
+		switch id := ids[i]; id {
+		case "missing":
+			if !tfresource.NotFound(err) {
+				t.Errorf("find(%s): expected NotFound error, got %v", id, err)
+			}
+		default:
+			if err != nil {
+				t.Errorf("find(%s): %s", id, err)
+			}
+		}
+	}
+
+	// A resource that no longer exists doesn't cause any further calls.
+	if got, want := len(calls.calls), 2; got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcher_error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, calls := newTestDescribeBatcher(100*time.Millisecond, 1000)
+	conn := &ec2.Client{}
+	ids := []string{"a", "failing", "b"}
+
+	release := calls.startBlocking(ctx, t, b, conn)
+	for i, err := range findConcurrently(ctx, b, conn, ids) {
+		if err == nil || tfresource.NotFound(err) {
+			t.Errorf("find(%s): expected error, got %v", ids[i], err)
+		}
+	}
+	release()
+
+	if got, want := len(calls.calls), 2; got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcher_notFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := newDescribeBatcher(func(context.Context, *ec2.Client, []string) ([]string, error) {
+		return nil, nil
+	}, func(v *string) string { return *v })
+	b.window = time.Millisecond
+
+	_, err := b.find(ctx, &ec2.Client{}, "a")
+
+	if !tfresource.NotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
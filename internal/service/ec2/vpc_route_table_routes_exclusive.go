@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_route_table_routes_exclusive", name="Route Table Routes Exclusive")
+func resourceRouteTableRoutesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRouteTableRoutesExclusiveCreate,
+		ReadWithoutTimeout:   resourceRouteTableRoutesExclusiveRead,
+		UpdateWithoutTimeout: resourceRouteTableRoutesExclusiveUpdate,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route": {
+				Type:       schema.TypeSet,
+				Optional:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						///
+						// Destinations.
+						///
+						names.AttrCIDRBlock: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+						},
+						"destination_prefix_list_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ipv6_cidr_block": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+						},
+						//
+						// Targets.
+						//
+						"carrier_gateway_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"core_network_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"egress_only_gateway_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"gateway_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"local_gateway_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"nat_gateway_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrNetworkInterfaceID: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrTransitGatewayID: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrVPCEndpointID: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_peering_connection_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				Set: resourceRouteTableHash,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRouteTableRoutesExclusiveCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeTableID := d.Get("route_table_id").(string)
+	if err := syncRouteTableRoutesExclusive(ctx, conn, d, routeTableID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route Table (%s) Routes Exclusive: %s", routeTableID, err)
+	}
+
+	d.SetId(routeTableID)
+
+	return append(diags, resourceRouteTableRoutesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRouteTableRoutesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeTable, err := findRouteTableByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing Routes Exclusive from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route Table (%s) Routes Exclusive: %s", d.Id(), err)
+	}
+
+	if err := d.Set("route", flattenRoutes(ctx, conn, d, routeTable.Routes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+	d.Set("route_table_id", routeTable.RouteTableId)
+
+	return diags
+}
+
+func resourceRouteTableRoutesExclusiveUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange("route") {
+		if err := syncRouteTableRoutesExclusive(ctx, conn, d, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route Table (%s) Routes Exclusive: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRouteTableRoutesExclusiveRead(ctx, d, meta)...)
+}
+
+// syncRouteTableRoutesExclusive brings the routes of the specified route table in line with the configured routes.
+//
+// The current routes are read from the route table rather than prior state so that routes added since the
+// last refresh are also removed. Local, propagated and VPC endpoint routes are never considered, matching
+// the routes exposed by aws_route_table.
+func syncRouteTableRoutesExclusive(ctx context.Context, conn *ec2.Client, d *schema.ResourceData, routeTableID string, timeout time.Duration) error {
+	routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+
+	if err != nil {
+		return err
+	}
+
+	have := make(map[string]map[string]any)
+	for _, v := range flattenRoutes(ctx, conn, d, routeTable.Routes) {
+		v := v.(map[string]any)
+		have[routeTableRoutesExclusiveDestinationKey(v)] = v
+	}
+
+	want := make(map[string]map[string]any)
+	for _, v := range d.Get("route").(*schema.Set).List() {
+		v := v.(map[string]any)
+		want[routeTableRoutesExclusiveDestinationKey(v)] = v
+	}
+
+	for destination, vOld := range have {
+		if _, ok := want[destination]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting unmanaged Route in Route Table (%s) with destination (%s)", routeTableID, destination)
+		if err := routeTableDeleteRoute(ctx, conn, routeTableID, vOld, timeout); err != nil {
+			return err
+		}
+	}
+
+	for destination, vNew := range want {
+		vOld, ok := have[destination]
+
+		if !ok {
+			if err := routeTableAddRoute(ctx, conn, routeTableID, vNew, timeout); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		oldTargetKey, oldTarget := routeTableRouteTargetAttribute(vOld)
+		newTargetKey, newTarget := routeTableRouteTargetAttribute(vNew)
+
+		if oldTargetKey != newTargetKey || oldTarget != newTarget {
+			if err := routeTableUpdateRoute(ctx, conn, routeTableID, vNew, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// routeTableRoutesExclusiveDestinationKey returns a key uniquely identifying the route table route's destination.
+func routeTableRoutesExclusiveDestinationKey(m map[string]any) string {
+	key, destination := routeTableRouteDestinationAttribute(m)
+
+	if key == "ipv6_cidr_block" {
+		destination = itypes.CanonicalCIDRBlock(destination)
+	}
+
+	return destination
+}
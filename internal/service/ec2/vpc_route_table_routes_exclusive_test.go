@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteTableRoutesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ipv4InternetGateway(rName, "10.2.0.0/16", "10.3.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", routeTableResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, "10.2.0.0/16", "gateway_id", igwResourceName, names.AttrID),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, "10.3.0.0/16", "gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ipv4InternetGateway(rName, "10.4.0.0/16", "10.3.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, "10.3.0.0/16", "gateway_id", igwResourceName, names.AttrID),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, "10.4.0.0/16", "gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+// An unmanaged route added out of band should be deleted
+func TestAccVPCRouteTableRoutesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ipv4InternetGateway(rName, "10.2.0.0/16", "10.3.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableRoutesExclusiveCreateRoute(ctx, &routeTable, "10.5.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ipv4InternetGateway(rName, "10.2.0.0/16", "10.3.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ipv4InternetGateway(rName, "10.2.0.0/16", "10.3.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 1),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_prefixList(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_prefixListInternetGateway(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 2),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					testAccCheckRouteTablePrefixListRoute(resourceName, plResourceName, "gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckRouteTableRoutesExclusiveCreateRoute returns a TestCheckFunc which creates a route
+// to the test internet gateway in the specified route table outside of Terraform.
+func testAccCheckRouteTableRoutesExclusiveCreateRoute(ctx context.Context, routeTable *awstypes.RouteTable, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		rs, ok := s.RootModule().Resources["aws_internet_gateway.test"]
+		if !ok {
+			return fmt.Errorf("Not found: %s", "aws_internet_gateway.test")
+		}
+
+		input := ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(destination),
+			GatewayId:            aws.String(rs.Primary.ID),
+			RouteTableId:         routeTable.RouteTableId,
+		}
+		_, err := conn.CreateRoute(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_ipv4InternetGateway(rName, destinationCidr1, destinationCidr2 string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    cidr_block = %[1]q
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  route {
+    cidr_block = %[2]q
+    gateway_id = aws_internet_gateway.test.id
+  }
+}
+`, destinationCidr1, destinationCidr2))
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesExclusiveConfig_base(rName), `
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+  route          = []
+}
+`)
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_prefixListInternetGateway(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+}
+
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    destination_prefix_list_id = aws_ec2_managed_prefix_list.test.id
+    gateway_id                 = aws_internet_gateway.test.id
+  }
+}
+`, rName))
+}
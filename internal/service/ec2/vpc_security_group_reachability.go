@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+const (
+	reachabilityDirectionEgress  = "egress"
+	reachabilityDirectionIngress = "ingress"
+)
+
+const (
+	// networkACLDefaultRuleNumber is the rule number reported for the implicit
+	// deny-all ("*") rule at the end of every network ACL.
+	networkACLDefaultRuleNumber = 32767
+)
+
+// reachabilityQuery describes the traffic being evaluated.
+// The peer is the source of ingress traffic or the destination of egress traffic.
+type reachabilityQuery struct {
+	direction           string
+	ipProtocol          string // Normalized by protocolForValue.
+	port                int64  // Port, or ICMP type. -1 if not specified.
+	peerCIDR            netip.Prefix
+	peerPrefixListID    string
+	peerSecurityGroupID string
+	prefixListEntries   map[string][]netip.Prefix
+}
+
+// peerCIDRs returns the CIDR blocks that the peer's traffic can originate from (or be sent to).
+func (q *reachabilityQuery) peerCIDRs() []netip.Prefix {
+	if q.peerCIDR.IsValid() {
+		return []netip.Prefix{q.peerCIDR}
+	}
+
+	if q.peerPrefixListID != "" {
+		return q.prefixListEntries[q.peerPrefixListID]
+	}
+
+	return nil
+}
+
+type reachabilitySecurityGroupRule struct {
+	cidr                      netip.Prefix
+	fromPort                  int64
+	ipProtocol                string // Normalized by protocolForValue.
+	prefixListID              string
+	referencedSecurityGroupID string
+	toPort                    int64
+}
+
+type reachabilityNetworkACLRule struct {
+	cidr       netip.Prefix
+	egress     bool
+	fromPort   int64
+	icmpType   int64
+	protocol   int
+	ruleAction awstypes.RuleAction
+	ruleNumber int64
+	toPort     int64
+}
+
+type reachabilityRoute struct {
+	cidr                    netip.Prefix
+	destinationPrefixListID string
+	target                  string
+}
+
+func (r *reachabilityRoute) destination() string {
+	if r.destinationPrefixListID != "" {
+		return r.destinationPrefixListID
+	}
+
+	return r.cidr.String()
+}
+
+type reachabilityResult struct {
+	networkACLAllowed    *bool
+	networkACLRuleNumber int64
+	route                *reachabilityRoute
+	routeFound           *bool
+	securityGroupRules   []int // Indexes of the security group rules allowing the traffic.
+}
+
+func (r *reachabilityResult) allowed() bool {
+	if len(r.securityGroupRules) == 0 {
+		return false
+	}
+
+	if r.networkACLAllowed != nil && !*r.networkACLAllowed {
+		return false
+	}
+
+	if r.routeFound != nil && !*r.routeFound {
+		return false
+	}
+
+	return true
+}
+
+// evaluateReachability computes locally whether the traffic described by q is allowed.
+// Security group rules are stateful and allow the traffic if any rule matches.
+// Network ACL rules are evaluated in ascending rule number order and the first matching rule wins;
+// they are only evaluated when at least one rule is supplied and the peer resolves to CIDR blocks.
+// Routes are evaluated by longest prefix match towards the peer, in the same circumstances.
+func evaluateReachability(q *reachabilityQuery, sgRules []reachabilitySecurityGroupRule, naclRules []reachabilityNetworkACLRule, routes []reachabilityRoute) *reachabilityResult {
+	result := &reachabilityResult{}
+
+	for i, rule := range sgRules {
+		if q.securityGroupRuleMatches(&rule) {
+			result.securityGroupRules = append(result.securityGroupRules, i)
+		}
+	}
+
+	peerCIDRs := q.peerCIDRs()
+
+	if len(naclRules) > 0 && len(peerCIDRs) > 0 {
+		rules := slices.Clone(naclRules)
+		slices.SortStableFunc(rules, func(a, b reachabilityNetworkACLRule) int {
+			return cmp.Compare(a.ruleNumber, b.ruleNumber)
+		})
+
+		allowed := true
+		for i, cidr := range peerCIDRs {
+			ruleNumber, ok := q.networkACLDecision(rules, cidr)
+
+			if i == 0 || (allowed && !ok) {
+				result.networkACLRuleNumber = ruleNumber
+			}
+			allowed = allowed && ok
+		}
+		result.networkACLAllowed = &allowed
+	}
+
+	if len(routes) > 0 && len(peerCIDRs) > 0 {
+		found := true
+		for i, cidr := range peerCIDRs {
+			route := q.longestPrefixMatch(routes, cidr)
+
+			if i == 0 {
+				result.route = route
+			}
+			found = found && route != nil
+		}
+		result.routeFound = &found
+	}
+
+	return result
+}
+
+func (q *reachabilityQuery) securityGroupRuleMatches(rule *reachabilitySecurityGroupRule) bool {
+	if rule.ipProtocol != "-1" {
+		if rule.ipProtocol != q.ipProtocol {
+			return false
+		}
+
+		switch rule.ipProtocol {
+		case "tcp", "udp":
+			if q.port < rule.fromPort || q.port > rule.toPort {
+				return false
+			}
+		case "icmp", "icmpv6":
+			// For ICMP, from_port is the ICMP type and -1 means all types.
+			if rule.fromPort != -1 && rule.fromPort != q.port {
+				return false
+			}
+		}
+	}
+
+	switch {
+	case rule.cidr.IsValid():
+		peerCIDRs := q.peerCIDRs()
+		if len(peerCIDRs) == 0 {
+			return false
+		}
+
+		return allPrefixesContained(peerCIDRs, []netip.Prefix{rule.cidr})
+	case rule.prefixListID != "":
+		if rule.prefixListID == q.peerPrefixListID {
+			return true
+		}
+
+		entries, peerCIDRs := q.prefixListEntries[rule.prefixListID], q.peerCIDRs()
+		if len(entries) == 0 || len(peerCIDRs) == 0 {
+			return false
+		}
+
+		return allPrefixesContained(peerCIDRs, entries)
+	case rule.referencedSecurityGroupID != "":
+		return q.peerSecurityGroupID != "" && securityGroupIDFromReference(rule.referencedSecurityGroupID) == securityGroupIDFromReference(q.peerSecurityGroupID)
+	}
+
+	return false
+}
+
+// networkACLDecision returns the number of the network ACL rule that decides the fate of traffic to or from cidr,
+// and whether that rule allows the traffic. rules must be sorted by rule number.
+func (q *reachabilityQuery) networkACLDecision(rules []reachabilityNetworkACLRule, cidr netip.Prefix) (int64, bool) {
+	egress := q.direction == reachabilityDirectionEgress
+	protocol, ok := securityGroupProtocolIntegers[q.ipProtocol]
+	if !ok {
+		var err error
+		if protocol, err = networkACLProtocolNumber(q.ipProtocol); err != nil {
+			protocol = -2 // Matches only "all" rules.
+		}
+	}
+
+	for _, rule := range rules {
+		if rule.egress != egress {
+			continue
+		}
+
+		if rule.protocol != -1 {
+			if rule.protocol != protocol {
+				continue
+			}
+
+			switch rule.protocol {
+			case 6, 17: // TCP, UDP.
+				if q.port < rule.fromPort || q.port > rule.toPort {
+					continue
+				}
+			case 1, 58: // ICMP, ICMPv6.
+				if rule.icmpType != -1 && rule.icmpType != q.port {
+					continue
+				}
+			}
+		}
+
+		if !prefixContains(rule.cidr, cidr) {
+			continue
+		}
+
+		return rule.ruleNumber, rule.ruleAction == awstypes.RuleActionAllow
+	}
+
+	return networkACLDefaultRuleNumber, false
+}
+
+// longestPrefixMatch returns the most specific route towards cidr, or nil if there is none.
+func (q *reachabilityQuery) longestPrefixMatch(routes []reachabilityRoute, cidr netip.Prefix) *reachabilityRoute {
+	var match *reachabilityRoute
+	bits := -1
+
+	for i, route := range routes {
+		destinations := []netip.Prefix{route.cidr}
+		if route.destinationPrefixListID != "" {
+			destinations = q.prefixListEntries[route.destinationPrefixListID]
+		}
+
+		for _, destination := range destinations {
+			if prefixContains(destination, cidr) && destination.Bits() > bits {
+				match, bits = &routes[i], destination.Bits()
+			}
+		}
+	}
+
+	return match
+}
+
+// reason returns a human-readable explanation of the result.
+func (r *reachabilityResult) reason(q *reachabilityQuery, sgRuleIDs []string) string {
+	var parts []string
+
+	if n := len(r.securityGroupRules); n == 0 {
+		parts = append(parts, fmt.Sprintf("no %s security group rule allows the traffic", q.direction))
+	} else {
+		ids := make([]string, 0, n)
+		for _, i := range r.securityGroupRules {
+			if v := sgRuleIDs[i]; v != "" {
+				ids = append(ids, v)
+			} else {
+				ids = append(ids, fmt.Sprintf("#%d", i))
+			}
+		}
+		parts = append(parts, fmt.Sprintf("allowed by %s security group rule(s) %s", q.direction, strings.Join(ids, ", ")))
+	}
+
+	if r.networkACLAllowed != nil {
+		action := "denied"
+		if *r.networkACLAllowed {
+			action = "allowed"
+		}
+		if r.networkACLRuleNumber == networkACLDefaultRuleNumber {
+			parts = append(parts, fmt.Sprintf("%s by the default network ACL rule", action))
+		} else {
+			parts = append(parts, fmt.Sprintf("%s by network ACL rule %d", action, r.networkACLRuleNumber))
+		}
+	}
+
+	if r.routeFound != nil {
+		if *r.routeFound {
+			parts = append(parts, fmt.Sprintf("routed via %s to %s", r.route.destination(), r.route.target))
+		} else {
+			parts = append(parts, "no route to the peer")
+		}
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// prefixContains returns whether inner lies entirely within outer.
+func prefixContains(outer, inner netip.Prefix) bool {
+	return outer.IsValid() && inner.IsValid() && outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+// allPrefixesContained returns whether each of inners lies entirely within one of outers.
+func allPrefixesContained(inners, outers []netip.Prefix) bool {
+	return !slices.ContainsFunc(inners, func(inner netip.Prefix) bool {
+		return !slices.ContainsFunc(outers, func(outer netip.Prefix) bool {
+			return prefixContains(outer, inner)
+		})
+	})
+}
+
+// securityGroupIDFromReference strips any leading account ID ("123456789012/sg-12345678").
+func securityGroupIDFromReference(v string) string {
+	if _, id, ok := strings.Cut(v, "/"); ok {
+		return id
+	}
+
+	return v
+}
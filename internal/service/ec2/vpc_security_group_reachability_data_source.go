@@ -0,0 +1,556 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_vpc_security_group_reachability", name="Security Group Reachability")
+// @Region(overrideEnabled=false)
+func newSecurityGroupReachabilityDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &securityGroupReachabilityDataSource{}, nil
+}
+
+type securityGroupReachabilityDataSource struct {
+	framework.DataSourceWithModel[securityGroupReachabilityDataSourceModel]
+}
+
+func (d *securityGroupReachabilityDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	cidrValidators := []validator.String{
+		stringvalidator.Any(fwvalidators.IPv4CIDRNetworkAddress(), fwvalidators.IPv6CIDRNetworkAddress()),
+	}
+	portValidators := []validator.Int64{
+		int64validator.Between(-1, 65535),
+	}
+
+	securityGroupRuleBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[securityGroupReachabilityRuleModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr_ipv4": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.IPv4CIDRNetworkAddress(),
+					},
+				},
+				"cidr_ipv6": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.IPv6CIDRNetworkAddress(),
+					},
+				},
+				names.AttrDescription: schema.StringAttribute{
+					Optional: true,
+				},
+				"from_port": schema.Int64Attribute{
+					Optional:   true,
+					Validators: portValidators,
+				},
+				"ip_protocol": schema.StringAttribute{
+					CustomType: ipProtocolType{},
+					Required:   true,
+				},
+				"prefix_list_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"referenced_security_group_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"security_group_rule_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"to_port": schema.Int64Attribute{
+					Optional:   true,
+					Validators: portValidators,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allowed": schema.BoolAttribute{
+				Computed: true,
+			},
+			"direction": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(reachabilityDirectionIngress, reachabilityDirectionEgress),
+				},
+			},
+			"matching_security_group_rules": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[securityGroupReachabilityMatchModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[securityGroupReachabilityMatchModel](ctx),
+				},
+			},
+			"network_acl_allowed": schema.BoolAttribute{
+				Computed: true,
+			},
+			"network_acl_rule_number": schema.Int64Attribute{
+				Computed: true,
+			},
+			"peer_cidr": schema.StringAttribute{
+				Optional:   true,
+				Validators: cidrValidators,
+			},
+			"peer_prefix_list_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"peer_security_group_id": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Optional:   true,
+				Validators: portValidators,
+			},
+			"prefix_list_entries": schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+			},
+			names.AttrProtocol: schema.StringAttribute{
+				CustomType: ipProtocolType{},
+				Required:   true,
+			},
+			"reason": schema.StringAttribute{
+				Computed: true,
+			},
+			"route_destination": schema.StringAttribute{
+				Computed: true,
+			},
+			"route_found": schema.BoolAttribute{
+				Computed: true,
+			},
+			"route_target": schema.StringAttribute{
+				Computed: true,
+			},
+			"security_group_allowed": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"network_acl_rule": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[securityGroupReachabilityNetworkACLRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrCIDRBlock: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.IPv4CIDRNetworkAddress(),
+							},
+						},
+						"egress": schema.BoolAttribute{
+							Optional: true,
+						},
+						"from_port": schema.Int64Attribute{
+							Optional:   true,
+							Validators: portValidators,
+						},
+						"icmp_code": schema.Int64Attribute{
+							Optional: true,
+						},
+						"icmp_type": schema.Int64Attribute{
+							Optional: true,
+						},
+						"ipv6_cidr_block": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.IPv6CIDRNetworkAddress(),
+							},
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							Required: true,
+						},
+						"rule_action": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.RuleAction](),
+							Required:   true,
+						},
+						"rule_number": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 32766),
+							},
+						},
+						"to_port": schema.Int64Attribute{
+							Optional:   true,
+							Validators: portValidators,
+						},
+					},
+				},
+			},
+			"route": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[securityGroupReachabilityRouteModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"carrier_gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrCIDRBlock: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.IPv4CIDRNetworkAddress(),
+							},
+						},
+						"core_network_arn": schema.StringAttribute{
+							Optional: true,
+						},
+						"destination_prefix_list_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"egress_only_gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"ipv6_cidr_block": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.IPv6CIDRNetworkAddress(),
+							},
+						},
+						"local_gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"nat_gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrNetworkInterfaceID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrTransitGatewayID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrVPCEndpointID: schema.StringAttribute{
+							Optional: true,
+						},
+						"vpc_peering_connection_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"security_group_egress_rule":  securityGroupRuleBlock,
+			"security_group_ingress_rule": securityGroupRuleBlock,
+		},
+	}
+}
+
+func (d *securityGroupReachabilityDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data securityGroupReachabilityDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PeerCIDR.IsNull() && data.PeerPrefixListID.IsNull() && data.PeerSecurityGroupID.IsNull() {
+		response.Diagnostics.AddAttributeError(path.Root("peer_cidr"), "Missing peer", "One of peer_cidr, peer_prefix_list_id or peer_security_group_id must be configured.")
+
+		return
+	}
+
+	if data.Direction.IsNull() || data.Direction.IsUnknown() {
+		data.Direction = types.StringValue(reachabilityDirectionIngress)
+	}
+
+	query := &reachabilityQuery{
+		direction:           data.Direction.ValueString(),
+		ipProtocol:          protocolForValue(data.Protocol.ValueString()),
+		port:                -1,
+		peerPrefixListID:    data.PeerPrefixListID.ValueString(),
+		peerSecurityGroupID: data.PeerSecurityGroupID.ValueString(),
+		prefixListEntries:   make(map[string][]netip.Prefix),
+	}
+
+	if !data.Port.IsNull() {
+		query.port = data.Port.ValueInt64()
+	} else if query.ipProtocol == "tcp" || query.ipProtocol == "udp" {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrPort), "Missing port", fmt.Sprintf("port must be configured for protocol %q.", query.ipProtocol))
+
+		return
+	}
+
+	if v := data.PeerCIDR.ValueString(); v != "" {
+		query.peerCIDR = netip.MustParsePrefix(v)
+	}
+
+	var prefixListEntries map[string][]string
+	response.Diagnostics.Append(data.PrefixListEntries.ElementsAs(ctx, &prefixListEntries, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for id, cidrs := range prefixListEntries {
+		for _, cidr := range cidrs {
+			prefix, err := netip.ParsePrefix(cidr)
+
+			if err != nil {
+				response.Diagnostics.AddAttributeError(path.Root("prefix_list_entries").AtMapKey(id), "Invalid CIDR block", err.Error())
+
+				return
+			}
+
+			query.prefixListEntries[id] = append(query.prefixListEntries[id], prefix.Masked())
+		}
+	}
+
+	if query.peerPrefixListID != "" && !query.peerCIDR.IsValid() && len(query.prefixListEntries[query.peerPrefixListID]) == 0 {
+		response.Diagnostics.AddWarning(
+			"Network ACL rules and routes not evaluated",
+			fmt.Sprintf("The entries of prefix list %s are not known. Configure them in prefix_list_entries to evaluate network ACL rules and routes.", query.peerPrefixListID),
+		)
+	}
+
+	sgRulesData := data.SecurityGroupIngressRules
+	if query.direction == reachabilityDirectionEgress {
+		sgRulesData = data.SecurityGroupEgressRules
+	}
+
+	sgRuleModels, diags := sgRulesData.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	naclRuleModels, diags := data.NetworkACLRules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	routeModels, diags := data.Routes.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sgRules := make([]reachabilitySecurityGroupRule, 0, len(sgRuleModels))
+	sgRuleIDs := make([]string, 0, len(sgRuleModels))
+	for _, v := range sgRuleModels {
+		sgRules = append(sgRules, v.expand())
+		sgRuleIDs = append(sgRuleIDs, v.SecurityGroupRuleID.ValueString())
+	}
+
+	naclRules := make([]reachabilityNetworkACLRule, 0, len(naclRuleModels))
+	for i, v := range naclRuleModels {
+		rule, err := v.expand()
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("network_acl_rule").AtListIndex(i), "Invalid network ACL rule", err.Error())
+
+			return
+		}
+
+		naclRules = append(naclRules, rule)
+	}
+
+	routes := make([]reachabilityRoute, 0, len(routeModels))
+	for _, v := range routeModels {
+		routes = append(routes, v.expand())
+	}
+
+	result := evaluateReachability(query, sgRules, naclRules, routes)
+
+	matches := make([]securityGroupReachabilityMatchModel, 0, len(result.securityGroupRules))
+	for _, i := range result.securityGroupRules {
+		matches = append(matches, securityGroupReachabilityMatchModel{
+			Description:         sgRuleModels[i].Description,
+			Index:               types.Int64Value(int64(i)),
+			SecurityGroupRuleID: sgRuleModels[i].SecurityGroupRuleID,
+		})
+	}
+
+	data.Allowed = types.BoolValue(result.allowed())
+	data.MatchingSecurityGroupRules = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, matches)
+	data.NetworkACLAllowed = types.BoolPointerValue(result.networkACLAllowed)
+	data.NetworkACLRuleNumber = types.Int64Null()
+	if result.networkACLAllowed != nil {
+		data.NetworkACLRuleNumber = types.Int64Value(result.networkACLRuleNumber)
+	}
+	data.Reason = types.StringValue(result.reason(query, sgRuleIDs))
+	data.RouteDestination, data.RouteTarget = types.StringNull(), types.StringNull()
+	if result.route != nil {
+		data.RouteDestination = types.StringValue(result.route.destination())
+		data.RouteTarget = types.StringValue(result.route.target)
+	}
+	data.RouteFound = types.BoolPointerValue(result.routeFound)
+	data.SecurityGroupAllowed = types.BoolValue(len(result.securityGroupRules) > 0)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type securityGroupReachabilityDataSourceModel struct {
+	Allowed                    types.Bool                                                                    `tfsdk:"allowed"`
+	Direction                  types.String                                                                  `tfsdk:"direction"`
+	MatchingSecurityGroupRules fwtypes.ListNestedObjectValueOf[securityGroupReachabilityMatchModel]          `tfsdk:"matching_security_group_rules"`
+	NetworkACLAllowed          types.Bool                                                                    `tfsdk:"network_acl_allowed"`
+	NetworkACLRuleNumber       types.Int64                                                                   `tfsdk:"network_acl_rule_number"`
+	NetworkACLRules            fwtypes.ListNestedObjectValueOf[securityGroupReachabilityNetworkACLRuleModel] `tfsdk:"network_acl_rule"`
+	PeerCIDR                   types.String                                                                  `tfsdk:"peer_cidr"`
+	PeerPrefixListID           types.String                                                                  `tfsdk:"peer_prefix_list_id"`
+	PeerSecurityGroupID        types.String                                                                  `tfsdk:"peer_security_group_id"`
+	Port                       types.Int64                                                                   `tfsdk:"port"`
+	PrefixListEntries          types.Map                                                                     `tfsdk:"prefix_list_entries"`
+	Protocol                   ipProtocol                                                                    `tfsdk:"protocol"`
+	Reason                     types.String                                                                  `tfsdk:"reason"`
+	RouteDestination           types.String                                                                  `tfsdk:"route_destination"`
+	RouteFound                 types.Bool                                                                    `tfsdk:"route_found"`
+	RouteTarget                types.String                                                                  `tfsdk:"route_target"`
+	Routes                     fwtypes.ListNestedObjectValueOf[securityGroupReachabilityRouteModel]          `tfsdk:"route"`
+	SecurityGroupAllowed       types.Bool                                                                    `tfsdk:"security_group_allowed"`
+	SecurityGroupEgressRules   fwtypes.ListNestedObjectValueOf[securityGroupReachabilityRuleModel]           `tfsdk:"security_group_egress_rule"`
+	SecurityGroupIngressRules  fwtypes.ListNestedObjectValueOf[securityGroupReachabilityRuleModel]           `tfsdk:"security_group_ingress_rule"`
+}
+
+// securityGroupReachabilityRuleModel mirrors the arguments of securityGroupRuleResourceModel.
+type securityGroupReachabilityRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                ipProtocol   `tfsdk:"ip_protocol"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	SecurityGroupRuleID       types.String `tfsdk:"security_group_rule_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
+}
+
+func (m *securityGroupReachabilityRuleModel) expand() reachabilitySecurityGroupRule {
+	rule := reachabilitySecurityGroupRule{
+		fromPort:                  -1,
+		ipProtocol:                protocolForValue(m.IPProtocol.ValueString()),
+		prefixListID:              m.PrefixListID.ValueString(),
+		referencedSecurityGroupID: m.ReferencedSecurityGroupID.ValueString(),
+		toPort:                    -1,
+	}
+
+	if !m.FromPort.IsNull() {
+		rule.fromPort = m.FromPort.ValueInt64()
+	}
+	if !m.ToPort.IsNull() {
+		rule.toPort = m.ToPort.ValueInt64()
+	}
+
+	// The attribute validators guarantee that any configured CIDR block parses.
+	if v := m.CIDRIPv4.ValueString(); v != "" {
+		rule.cidr = netip.MustParsePrefix(v)
+	} else if v := m.CIDRIPv6.ValueString(); v != "" {
+		rule.cidr = netip.MustParsePrefix(v)
+	}
+
+	return rule
+}
+
+// securityGroupReachabilityNetworkACLRuleModel mirrors the arguments of the aws_network_acl_rule resource.
+type securityGroupReachabilityNetworkACLRuleModel struct {
+	CIDRBlock     types.String                            `tfsdk:"cidr_block"`
+	Egress        types.Bool                              `tfsdk:"egress"`
+	FromPort      types.Int64                             `tfsdk:"from_port"`
+	ICMPCode      types.Int64                             `tfsdk:"icmp_code"`
+	ICMPType      types.Int64                             `tfsdk:"icmp_type"`
+	IPv6CIDRBlock types.String                            `tfsdk:"ipv6_cidr_block"`
+	Protocol      types.String                            `tfsdk:"protocol"`
+	RuleAction    fwtypes.StringEnum[awstypes.RuleAction] `tfsdk:"rule_action"`
+	RuleNumber    types.Int64                             `tfsdk:"rule_number"`
+	ToPort        types.Int64                             `tfsdk:"to_port"`
+}
+
+func (m *securityGroupReachabilityNetworkACLRuleModel) expand() (reachabilityNetworkACLRule, error) {
+	protocol, err := networkACLProtocolNumber(m.Protocol.ValueString())
+
+	if err != nil {
+		return reachabilityNetworkACLRule{}, err
+	}
+
+	rule := reachabilityNetworkACLRule{
+		egress:     m.Egress.ValueBool(),
+		fromPort:   m.FromPort.ValueInt64(),
+		icmpType:   -1,
+		protocol:   protocol,
+		ruleAction: m.RuleAction.ValueEnum(),
+		ruleNumber: m.RuleNumber.ValueInt64(),
+		toPort:     m.ToPort.ValueInt64(),
+	}
+
+	if !m.ICMPType.IsNull() {
+		rule.icmpType = m.ICMPType.ValueInt64()
+	}
+
+	switch cidrBlock, ipv6CIDRBlock := m.CIDRBlock.ValueString(), m.IPv6CIDRBlock.ValueString(); {
+	case cidrBlock != "" && ipv6CIDRBlock == "":
+		rule.cidr = netip.MustParsePrefix(cidrBlock)
+	case cidrBlock == "" && ipv6CIDRBlock != "":
+		rule.cidr = netip.MustParsePrefix(ipv6CIDRBlock)
+	default:
+		return reachabilityNetworkACLRule{}, errors.New("exactly one of cidr_block or ipv6_cidr_block must be configured")
+	}
+
+	return rule, nil
+}
+
+// securityGroupReachabilityRouteModel mirrors the route arguments of the aws_route_table resource.
+type securityGroupReachabilityRouteModel struct {
+	CarrierGatewayID        types.String `tfsdk:"carrier_gateway_id"`
+	CIDRBlock               types.String `tfsdk:"cidr_block"`
+	CoreNetworkARN          types.String `tfsdk:"core_network_arn"`
+	DestinationPrefixListID types.String `tfsdk:"destination_prefix_list_id"`
+	EgressOnlyGatewayID     types.String `tfsdk:"egress_only_gateway_id"`
+	GatewayID               types.String `tfsdk:"gateway_id"`
+	IPv6CIDRBlock           types.String `tfsdk:"ipv6_cidr_block"`
+	LocalGatewayID          types.String `tfsdk:"local_gateway_id"`
+	NATGatewayID            types.String `tfsdk:"nat_gateway_id"`
+	NetworkInterfaceID      types.String `tfsdk:"network_interface_id"`
+	TransitGatewayID        types.String `tfsdk:"transit_gateway_id"`
+	VPCEndpointID           types.String `tfsdk:"vpc_endpoint_id"`
+	VPCPeeringConnectionID  types.String `tfsdk:"vpc_peering_connection_id"`
+}
+
+func (m *securityGroupReachabilityRouteModel) expand() reachabilityRoute {
+	route := reachabilityRoute{
+		destinationPrefixListID: m.DestinationPrefixListID.ValueString(),
+	}
+
+	if v := m.CIDRBlock.ValueString(); v != "" {
+		route.cidr = netip.MustParsePrefix(v)
+	} else if v := m.IPv6CIDRBlock.ValueString(); v != "" {
+		route.cidr = netip.MustParsePrefix(v)
+	}
+
+	for _, v := range []types.String{
+		m.CarrierGatewayID,
+		m.CoreNetworkARN,
+		m.EgressOnlyGatewayID,
+		m.GatewayID,
+		m.LocalGatewayID,
+		m.NATGatewayID,
+		m.NetworkInterfaceID,
+		m.TransitGatewayID,
+		m.VPCEndpointID,
+		m.VPCPeeringConnectionID,
+	} {
+		if v := v.ValueString(); v != "" {
+			route.target = v
+			break
+		}
+	}
+
+	return route
+}
+
+type securityGroupReachabilityMatchModel struct {
+	Description         types.String `tfsdk:"description"`
+	Index               types.Int64  `tfsdk:"index"`
+	SecurityGroupRuleID types.String `tfsdk:"security_group_rule_id"`
+}
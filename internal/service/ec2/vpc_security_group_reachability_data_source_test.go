@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupReachabilityDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_security_group_reachability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupReachabilityDataSourceConfig_basic("10.1.2.0/24", 443),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "direction", "ingress"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_group_allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "matching_security_group_rules.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "matching_security_group_rules.0.index", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "matching_security_group_rules.0.security_group_rule_id", "sgr-11111111"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acl_allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acl_rule_number", "200"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_found", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "route_destination", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_target", "tgw-11111111"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupReachabilityDataSourceConfig_basic("10.66.2.0/24", 443),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "security_group_allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acl_allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acl_rule_number", "100"),
+					resource.TestCheckResourceAttr(dataSourceName, "reason", "allowed by ingress security group rule(s) sgr-11111111; denied by network ACL rule 100; routed via 10.0.0.0/8 to tgw-11111111"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupReachabilityDataSourceConfig_basic("10.1.2.0/24", 22),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "security_group_allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "matching_security_group_rules.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acl_rule_number", "32767"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupReachabilityDataSource_referencedSecurityGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_security_group_reachability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupReachabilityDataSourceConfig_referencedSecurityGroup,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "direction", "egress"),
+					resource.TestCheckResourceAttr(dataSourceName, "matching_security_group_rules.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "matching_security_group_rules.0.index", "1"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "network_acl_allowed"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "route_found"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupReachabilityDataSource_missingPort(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSecurityGroupReachabilityDataSourceConfig_missingPort,
+				ExpectError: regexache.MustCompile(`port must be configured for protocol "tcp"`),
+			},
+		},
+	})
+}
+
+func testAccVPCSecurityGroupReachabilityDataSourceConfig_basic(peerCIDR string, port int) string {
+	return fmt.Sprintf(`
+data "aws_vpc_security_group_reachability" "test" {
+  peer_cidr = %[1]q
+  protocol  = "tcp"
+  port      = %[2]d
+
+  security_group_ingress_rule {
+    security_group_rule_id = "sgr-11111111"
+    cidr_ipv4              = "10.0.0.0/8"
+    ip_protocol            = "tcp"
+    from_port              = 443
+    to_port                = 443
+  }
+
+  security_group_ingress_rule {
+    cidr_ipv4   = "192.168.0.0/16"
+    ip_protocol = "-1"
+  }
+
+  network_acl_rule {
+    rule_number = 100
+    protocol    = "-1"
+    rule_action = "deny"
+    cidr_block  = "10.66.0.0/16"
+  }
+
+  network_acl_rule {
+    rule_number = 200
+    protocol    = "tcp"
+    rule_action = "allow"
+    cidr_block  = "0.0.0.0/0"
+    from_port   = 443
+    to_port     = 443
+  }
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = "igw-11111111"
+  }
+
+  route {
+    cidr_block         = "10.0.0.0/8"
+    transit_gateway_id = "tgw-11111111"
+  }
+}
+`, peerCIDR, port)
+}
+
+const testAccVPCSecurityGroupReachabilityDataSourceConfig_referencedSecurityGroup = `
+data "aws_vpc_security_group_reachability" "test" {
+  direction              = "egress"
+  peer_security_group_id = "sg-22222222"
+  protocol               = "6"
+  port                   = 5432
+
+  security_group_egress_rule {
+    cidr_ipv4   = "0.0.0.0/0"
+    ip_protocol = "tcp"
+    from_port   = 443
+    to_port     = 443
+  }
+
+  security_group_egress_rule {
+    referenced_security_group_id = "sg-22222222"
+    ip_protocol                  = "tcp"
+    from_port                    = 5432
+    to_port                      = 5432
+  }
+
+  network_acl_rule {
+    rule_number = 100
+    egress      = true
+    protocol    = "-1"
+    rule_action = "deny"
+    cidr_block  = "0.0.0.0/0"
+  }
+}
+`
+
+const testAccVPCSecurityGroupReachabilityDataSourceConfig_missingPort = `
+data "aws_vpc_security_group_reachability" "test" {
+  peer_cidr = "10.0.0.0/8"
+  protocol  = "tcp"
+}
+`
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"net/netip"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvaluateReachability_securityGroupRules(t *testing.T) {
+	t.Parallel()
+
+	sgRules := []reachabilitySecurityGroupRule{
+		{cidr: netip.MustParsePrefix("10.0.0.0/8"), ipProtocol: "tcp", fromPort: 443, toPort: 443},
+		{cidr: netip.MustParsePrefix("10.1.0.0/16"), ipProtocol: "tcp", fromPort: 8000, toPort: 8999},
+		{referencedSecurityGroupID: "123456789012/sg-11111111", ipProtocol: "-1", fromPort: -1, toPort: -1},
+		{prefixListID: "pl-11111111", ipProtocol: "udp", fromPort: 53, toPort: 53},
+		{cidr: netip.MustParsePrefix("2001:db8::/32"), ipProtocol: "icmpv6", fromPort: -1, toPort: -1},
+		{cidr: netip.MustParsePrefix("0.0.0.0/0"), ipProtocol: "icmp", fromPort: 8, toPort: -1},
+	}
+
+	testCases := map[string]struct {
+		query reachabilityQuery
+		want  []int
+	}{
+		"cidr within rule": {
+			query: reachabilityQuery{ipProtocol: "tcp", port: 443, peerCIDR: netip.MustParsePrefix("10.20.0.0/16")},
+			want:  []int{0},
+		},
+		"cidr wider than rule": {
+			query: reachabilityQuery{ipProtocol: "tcp", port: 8080, peerCIDR: netip.MustParsePrefix("10.0.0.0/8")},
+		},
+		"port range": {
+			query: reachabilityQuery{ipProtocol: "tcp", port: 8080, peerCIDR: netip.MustParsePrefix("10.1.2.3/32")},
+			want:  []int{1},
+		},
+		"wrong protocol": {
+			query: reachabilityQuery{ipProtocol: "udp", port: 443, peerCIDR: netip.MustParsePrefix("10.20.0.0/16")},
+		},
+		"referenced security group": {
+			query: reachabilityQuery{ipProtocol: "tcp", port: 22, peerSecurityGroupID: "sg-11111111"},
+			want:  []int{2},
+		},
+		"prefix list by ID": {
+			query: reachabilityQuery{ipProtocol: "udp", port: 53, peerPrefixListID: "pl-11111111"},
+			want:  []int{3},
+		},
+		"cidr within prefix list entries": {
+			query: reachabilityQuery{
+				ipProtocol:        "udp",
+				port:              53,
+				peerCIDR:          netip.MustParsePrefix("192.168.1.0/24"),
+				prefixListEntries: map[string][]netip.Prefix{"pl-11111111": {netip.MustParsePrefix("192.168.0.0/16")}},
+			},
+			want: []int{3},
+		},
+		"prefix list entries within cidr": {
+			query: reachabilityQuery{
+				ipProtocol:        "tcp",
+				port:              443,
+				peerPrefixListID:  "pl-22222222",
+				prefixListEntries: map[string][]netip.Prefix{"pl-22222222": {netip.MustParsePrefix("10.1.0.0/16"), netip.MustParsePrefix("10.2.0.0/16")}},
+			},
+			want: []int{0},
+		},
+		"prefix list entries partially outside cidr": {
+			query: reachabilityQuery{
+				ipProtocol:        "tcp",
+				port:              443,
+				peerPrefixListID:  "pl-22222222",
+				prefixListEntries: map[string][]netip.Prefix{"pl-22222222": {netip.MustParsePrefix("10.1.0.0/16"), netip.MustParsePrefix("172.16.0.0/12")}},
+			},
+		},
+		"ipv6 all types": {
+			query: reachabilityQuery{ipProtocol: "icmpv6", port: 128, peerCIDR: netip.MustParsePrefix("2001:db8:1::/48")},
+			want:  []int{4},
+		},
+		"icmp type": {
+			query: reachabilityQuery{ipProtocol: "icmp", port: 8, peerCIDR: netip.MustParsePrefix("203.0.113.0/24")},
+			want:  []int{5},
+		},
+		"icmp other type": {
+			query: reachabilityQuery{ipProtocol: "icmp", port: 0, peerCIDR: netip.MustParsePrefix("203.0.113.0/24")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := evaluateReachability(&testCase.query, sgRules, nil, nil)
+
+			if diff := cmp.Diff(got.securityGroupRules, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+			if got, want := got.allowed(), len(testCase.want) > 0; got != want {
+				t.Errorf("allowed = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestEvaluateReachability_networkACLRules(t *testing.T) {
+	t.Parallel()
+
+	sgRules := []reachabilitySecurityGroupRule{
+		{cidr: netip.MustParsePrefix("0.0.0.0/0"), ipProtocol: "-1", fromPort: -1, toPort: -1},
+	}
+	naclRules := []reachabilityNetworkACLRule{
+		{ruleNumber: 200, cidr: netip.MustParsePrefix("0.0.0.0/0"), protocol: 6, fromPort: 443, toPort: 443, ruleAction: awstypes.RuleActionAllow},
+		{ruleNumber: 100, cidr: netip.MustParsePrefix("10.66.0.0/16"), protocol: -1, ruleAction: awstypes.RuleActionDeny},
+		{ruleNumber: 100, egress: true, cidr: netip.MustParsePrefix("0.0.0.0/0"), protocol: -1, ruleAction: awstypes.RuleActionAllow},
+		{ruleNumber: 300, cidr: netip.MustParsePrefix("0.0.0.0/0"), protocol: 1, icmpType: -1, ruleAction: awstypes.RuleActionAllow},
+	}
+
+	testCases := map[string]struct {
+		query          reachabilityQuery
+		wantAllowed    bool
+		wantRuleNumber int64
+	}{
+		"allowed": {
+			query:          reachabilityQuery{direction: reachabilityDirectionIngress, ipProtocol: "tcp", port: 443, peerCIDR: netip.MustParsePrefix("10.1.0.0/16")},
+			wantAllowed:    true,
+			wantRuleNumber: 200,
+		},
+		"lower rule number wins": {
+			query:          reachabilityQuery{direction: reachabilityDirectionIngress, ipProtocol: "tcp", port: 443, peerCIDR: netip.MustParsePrefix("10.66.1.0/24")},
+			wantRuleNumber: 100,
+		},
+		"default rule": {
+			query:          reachabilityQuery{direction: reachabilityDirectionIngress, ipProtocol: "tcp", port: 22, peerCIDR: netip.MustParsePrefix("10.1.0.0/16")},
+			wantRuleNumber: networkACLDefaultRuleNumber,
+		},
+		"egress": {
+			query:          reachabilityQuery{direction: reachabilityDirectionEgress, ipProtocol: "tcp", port: 22, peerCIDR: netip.MustParsePrefix("10.1.0.0/16")},
+			wantAllowed:    true,
+			wantRuleNumber: 100,
+		},
+		"icmp": {
+			query:          reachabilityQuery{direction: reachabilityDirectionIngress, ipProtocol: "icmp", port: 8, peerCIDR: netip.MustParsePrefix("192.0.2.0/24")},
+			wantAllowed:    true,
+			wantRuleNumber: 300,
+		},
+		"prefix list entry denied": {
+			query: reachabilityQuery{
+				direction:         reachabilityDirectionIngress,
+				ipProtocol:        "tcp",
+				port:              443,
+				peerPrefixListID:  "pl-11111111",
+				prefixListEntries: map[string][]netip.Prefix{"pl-11111111": {netip.MustParsePrefix("10.1.0.0/16"), netip.MustParsePrefix("10.66.0.0/16")}},
+			},
+			wantRuleNumber: 100,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := evaluateReachability(&testCase.query, sgRules, naclRules, nil)
+
+			if got.networkACLAllowed == nil {
+				t.Fatal("network ACL rules not evaluated")
+			}
+			if got, want := *got.networkACLAllowed, testCase.wantAllowed; got != want {
+				t.Errorf("networkACLAllowed = %t, want %t", got, want)
+			}
+			if got, want := got.networkACLRuleNumber, testCase.wantRuleNumber; got != want {
+				t.Errorf("networkACLRuleNumber = %d, want %d", got, want)
+			}
+			if got, want := got.allowed(), testCase.wantAllowed; got != want {
+				t.Errorf("allowed = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestEvaluateReachability_routes(t *testing.T) {
+	t.Parallel()
+
+	sgRules := []reachabilitySecurityGroupRule{
+		{cidr: netip.MustParsePrefix("0.0.0.0/0"), ipProtocol: "-1", fromPort: -1, toPort: -1},
+	}
+	routes := []reachabilityRoute{
+		{cidr: netip.MustParsePrefix("10.0.0.0/16"), target: "local"},
+		{cidr: netip.MustParsePrefix("0.0.0.0/0"), target: "igw-11111111"},
+		{destinationPrefixListID: "pl-11111111", target: "tgw-11111111"},
+	}
+	prefixListEntries := map[string][]netip.Prefix{
+		"pl-11111111": {netip.MustParsePrefix("172.16.0.0/12")},
+	}
+
+	testCases := map[string]struct {
+		query           reachabilityQuery
+		wantDestination string
+		wantTarget      string
+	}{
+		"local": {
+			query:           reachabilityQuery{ipProtocol: "tcp", port: 443, peerCIDR: netip.MustParsePrefix("10.0.1.0/24"), prefixListEntries: prefixListEntries},
+			wantDestination: "10.0.0.0/16",
+			wantTarget:      "local",
+		},
+		"default route": {
+			query:           reachabilityQuery{ipProtocol: "tcp", port: 443, peerCIDR: netip.MustParsePrefix("198.51.100.0/24"), prefixListEntries: prefixListEntries},
+			wantDestination: "0.0.0.0/0",
+			wantTarget:      "igw-11111111",
+		},
+		"prefix list": {
+			query:           reachabilityQuery{ipProtocol: "tcp", port: 443, peerCIDR: netip.MustParsePrefix("172.20.0.0/16"), prefixListEntries: prefixListEntries},
+			wantDestination: "pl-11111111",
+			wantTarget:      "tgw-11111111",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := evaluateReachability(&testCase.query, sgRules, nil, routes)
+
+			if got.routeFound == nil || !*got.routeFound {
+				t.Fatal("route not found")
+			}
+			if got, want := got.route.destination(), testCase.wantDestination; got != want {
+				t.Errorf("route destination = %s, want %s", got, want)
+			}
+			if got, want := got.route.target, testCase.wantTarget; got != want {
+				t.Errorf("route target = %s, want %s", got, want)
+			}
+		})
+	}
+
+	t.Run("no route", func(t *testing.T) {
+		t.Parallel()
+
+		query := reachabilityQuery{ipProtocol: "tcp", port: 443, peerCIDR: netip.MustParsePrefix("2001:db8::/32")}
+		got := evaluateReachability(&query, sgRules, nil, routes)
+
+		if got.routeFound == nil || *got.routeFound {
+			t.Fatal("unexpected route found")
+		}
+		if got.allowed() {
+			t.Error("allowed = true, want false")
+		}
+	})
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityGroupRulesExclusiveResource{}, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithModel[securityGroupRulesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"revoke_default_egress": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncRules(ctx, &plan, create.ErrActionCreating)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := state.SecurityGroupID.ValueString()
+	out, err := findSecurityGroupRulesExclusiveBySecurityGroupID(ctx, conn, groupID)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, groupID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	ruleIDs := fwflex.ExpandFrameworkStringValueSet(ctx, state.SecurityGroupRuleIDs)
+	var ids []string
+	for _, rule := range out {
+		id := aws.ToString(rule.SecurityGroupRuleId)
+		// The default egress rule is only reported as drift when it is to be revoked.
+		if isDefaultEgressSecurityGroupRule(rule) && !state.RevokeDefaultEgress.ValueBool() && !slices.Contains(ruleIDs, id) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	state.SecurityGroupRuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, ids)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SecurityGroupRuleIDs.Equal(state.SecurityGroupRuleIDs) || !plan.RevokeDefaultEgress.Equal(state.RevokeDefaultEgress) {
+		resp.Diagnostics.Append(r.syncRules(ctx, &plan, create.ErrActionUpdating)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured security group rules in sync
+// with the remote resource.
+//
+// Rules of the security group not configured on this resource will be
+// revoked, except for the default egress rule unless revoke_default_egress
+// is set. A warning describing each revoked rule is returned so that the
+// removed drift is visible on every apply.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, plan *securityGroupRulesExclusiveResourceModel, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := plan.SecurityGroupID.ValueString()
+	have, err := findSecurityGroupRulesExclusiveBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.EC2, action, ResNameSecurityGroupRulesExclusive, groupID, err),
+			err.Error(),
+		)
+		return diags
+	}
+
+	want := fwflex.ExpandFrameworkStringValueSet(ctx, plan.SecurityGroupRuleIDs)
+	for _, id := range want {
+		if !slices.ContainsFunc(have, func(rule awstypes.SecurityGroupRule) bool { return aws.ToString(rule.SecurityGroupRuleId) == id }) {
+			err := fmt.Errorf("Security Group Rule (%s) not found in Security Group (%s)", id, groupID)
+			diags.AddError(
+				create.ProblemStandardMessage(names.EC2, action, ResNameSecurityGroupRulesExclusive, groupID, err),
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	var ingress, egress []awstypes.SecurityGroupRule
+	for _, rule := range have {
+		if slices.Contains(want, aws.ToString(rule.SecurityGroupRuleId)) {
+			continue
+		}
+		if isDefaultEgressSecurityGroupRule(rule) && !plan.RevokeDefaultEgress.ValueBool() {
+			continue
+		}
+
+		if aws.ToBool(rule.IsEgress) {
+			egress = append(egress, rule)
+		} else {
+			ingress = append(ingress, rule)
+		}
+	}
+
+	if len(ingress) > 0 {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: securityGroupRuleIDs(ingress),
+		}
+		_, err := conn.RevokeSecurityGroupIngress(ctx, &input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidPermissionNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			diags.AddError(
+				create.ProblemStandardMessage(names.EC2, action, ResNameSecurityGroupRulesExclusive, groupID, err),
+				fmt.Sprintf("revoking ingress rules: %s", err),
+			)
+			return diags
+		}
+	}
+
+	if len(egress) > 0 {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: securityGroupRuleIDs(egress),
+		}
+		_, err := conn.RevokeSecurityGroupEgress(ctx, &input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidPermissionNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			diags.AddError(
+				create.ProblemStandardMessage(names.EC2, action, ResNameSecurityGroupRulesExclusive, groupID, err),
+				fmt.Sprintf("revoking egress rules: %s", err),
+			)
+			return diags
+		}
+	}
+
+	if revoked := append(ingress, egress...); len(revoked) > 0 {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "The following rules of Security Group (%s) were not configured and have been revoked:\n", groupID)
+		for _, rule := range revoked {
+			fmt.Fprintf(&sb, "\n  - %s", securityGroupRuleSummary(rule))
+		}
+
+		diags.AddWarning(fmt.Sprintf("Revoked %d unmanaged Security Group rule(s)", len(revoked)), sb.String())
+	}
+
+	return diags
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), req, resp)
+}
+
+func findSecurityGroupRulesExclusiveBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.SecurityGroupRule, error) {
+	// Rules are listed with a filter, which doesn't fail for a security group that doesn't exist.
+	if _, err := findSecurityGroupByID(ctx, conn, id); err != nil {
+		return nil, err
+	}
+
+	return findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+}
+
+// isDefaultEgressSecurityGroupRule returns whether the rule is one of the "allow all" egress rules
+// that AWS adds to every new security group.
+func isDefaultEgressSecurityGroupRule(rule awstypes.SecurityGroupRule) bool {
+	if !aws.ToBool(rule.IsEgress) || aws.ToString(rule.IpProtocol) != "-1" {
+		return false
+	}
+	if rule.ReferencedGroupInfo != nil || aws.ToString(rule.PrefixListId) != "" || aws.ToString(rule.Description) != "" {
+		return false
+	}
+
+	return aws.ToString(rule.CidrIpv4) == "0.0.0.0/0" || aws.ToString(rule.CidrIpv6) == "::/0"
+}
+
+func securityGroupRuleIDs(rules []awstypes.SecurityGroupRule) []string {
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, aws.ToString(rule.SecurityGroupRuleId))
+	}
+
+	return ids
+}
+
+// securityGroupRuleSummary returns a one-line, human-readable description of a security group rule,
+// e.g. `sgr-0123456789abcdef0 (ingress tcp 22-22 from 10.0.0.0/8)`.
+func securityGroupRuleSummary(rule awstypes.SecurityGroupRule) string {
+	direction, preposition := "ingress", "from"
+	if aws.ToBool(rule.IsEgress) {
+		direction, preposition = "egress", "to"
+	}
+
+	protocol := aws.ToString(rule.IpProtocol)
+	if protocol == "-1" {
+		protocol = "all"
+	} else {
+		protocol = fmt.Sprintf("%s %d-%d", protocol, aws.ToInt32(rule.FromPort), aws.ToInt32(rule.ToPort))
+	}
+
+	var peer string
+	switch {
+	case aws.ToString(rule.CidrIpv4) != "":
+		peer = aws.ToString(rule.CidrIpv4)
+	case aws.ToString(rule.CidrIpv6) != "":
+		peer = aws.ToString(rule.CidrIpv6)
+	case aws.ToString(rule.PrefixListId) != "":
+		peer = aws.ToString(rule.PrefixListId)
+	case rule.ReferencedGroupInfo != nil:
+		peer = aws.ToString(rule.ReferencedGroupInfo.GroupId)
+	}
+
+	return fmt.Sprintf("%s (%s %s %s %s)", aws.ToString(rule.SecurityGroupRuleId), direction, protocol, preposition, peer)
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	RevokeDefaultEgress  types.Bool          `tfsdk:"revoke_default_egress"`
+	SecurityGroupID      types.String        `tfsdk:"security_group_id"`
+	SecurityGroupRuleIDs fwtypes.SetOfString `tfsdk:"security_group_rule_ids"`
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIsDefaultEgressSecurityGroupRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rule     awstypes.SecurityGroupRule
+		expected bool
+	}{
+		"default IPv4": {
+			rule:     awstypes.SecurityGroupRule{IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"), CidrIpv4: aws.String("0.0.0.0/0")},
+			expected: true,
+		},
+		"default IPv6": {
+			rule:     awstypes.SecurityGroupRule{IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"), CidrIpv6: aws.String("::/0")},
+			expected: true,
+		},
+		"ingress": {
+			rule: awstypes.SecurityGroupRule{IsEgress: aws.Bool(false), IpProtocol: aws.String("-1"), CidrIpv4: aws.String("0.0.0.0/0")},
+		},
+		"tcp": {
+			rule: awstypes.SecurityGroupRule{IsEgress: aws.Bool(true), IpProtocol: aws.String("tcp"), FromPort: aws.Int32(443), ToPort: aws.Int32(443), CidrIpv4: aws.String("0.0.0.0/0")},
+		},
+		"described": {
+			rule: awstypes.SecurityGroupRule{IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"), CidrIpv4: aws.String("0.0.0.0/0"), Description: aws.String("all traffic")},
+		},
+		"private CIDR": {
+			rule: awstypes.SecurityGroupRule{IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"), CidrIpv4: aws.String("10.0.0.0/8")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfec2.IsDefaultEgressSecurityGroupRule(testCase.rule); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupRuleSummary(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rule     awstypes.SecurityGroupRule
+		expected string
+	}{
+		"ingress CIDR": {
+			rule:     awstypes.SecurityGroupRule{SecurityGroupRuleId: aws.String("sgr-1"), IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int32(22), ToPort: aws.Int32(22), CidrIpv4: aws.String("0.0.0.0/0")},
+			expected: "sgr-1 (ingress tcp 22-22 from 0.0.0.0/0)",
+		},
+		"egress all": {
+			rule:     awstypes.SecurityGroupRule{SecurityGroupRuleId: aws.String("sgr-2"), IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"), CidrIpv6: aws.String("::/0")},
+			expected: "sgr-2 (egress all to ::/0)",
+		},
+		"referenced group": {
+			rule:     awstypes.SecurityGroupRule{SecurityGroupRuleId: aws.String("sgr-3"), IsEgress: aws.Bool(false), IpProtocol: aws.String("udp"), FromPort: aws.Int32(53), ToPort: aws.Int32(53), ReferencedGroupInfo: &awstypes.ReferencedSecurityGroup{GroupId: aws.String("sg-1")}},
+			expected: "sgr-3 (ingress udp 53-53 from sg-1)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfec2.SecurityGroupRuleSummary(testCase.rule); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+	securityGroupResourceName := "aws_security_group.test"
+	ruleResourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", securityGroupResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "revoke_default_egress", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_rule_ids.*", ruleResourceName, "security_group_rule_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "security_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "security_group_id",
+			},
+		},
+	})
+}
+
+// A rule added out of band should be revoked
+func TestAccVPCSecurityGroupRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					testAccCheckSecurityGroupRulesExclusiveAuthorizeIngress(ctx, resourceName, "192.168.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_revokeDefaultEgress(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					testAccCheckSecurityGroupRulesExclusiveAuthorizeDefaultEgress(ctx, resourceName),
+				),
+			},
+			{
+				// The default egress rule is left alone.
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveRuleCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_revokeDefaultEgress(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveRuleCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "revoke_default_egress", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesExclusiveExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, name, errors.New("not found"))
+		}
+
+		groupID := rs.Primary.Attributes["security_group_id"]
+		if groupID == "" {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+		out, err := tfec2.FindSecurityGroupRulesExclusiveBySecurityGroupID(ctx, conn, groupID)
+		if err != nil {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, groupID, err)
+		}
+
+		ruleCount := rs.Primary.Attributes["security_group_rule_ids.#"]
+		if ruleCount != strconv.Itoa(len(out)) {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, groupID, errors.New("unexpected security_group_rule_ids count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveRuleCount(ctx context.Context, name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+		out, err := tfec2.FindSecurityGroupRulesExclusiveBySecurityGroupID(ctx, conn, rs.Primary.Attributes["security_group_id"])
+		if err != nil {
+			return err
+		}
+
+		if len(out) != expected {
+			return fmt.Errorf("expected %d security group rules, got %d", expected, len(out))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveAuthorizeIngress(ctx context.Context, name, cidr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+		input := ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: aws.String(rs.Primary.Attributes["security_group_id"]),
+			IpPermissions: []awstypes.IpPermission{{
+				FromPort:   aws.Int32(22),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []awstypes.IpRange{{CidrIp: aws.String(cidr)}},
+				ToPort:     aws.Int32(22),
+			}},
+		}
+		_, err := conn.AuthorizeSecurityGroupIngress(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveAuthorizeDefaultEgress(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+		input := ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId: aws.String(rs.Primary.Attributes["security_group_id"]),
+			IpPermissions: []awstypes.IpPermission{{
+				IpProtocol: aws.String("-1"),
+				IpRanges:   []awstypes.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+			}},
+		}
+		_, err := conn.AuthorizeSecurityGroupEgress(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupIngressRuleConfig_basic(rName), `
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id       = aws_security_group.test.id
+  security_group_rule_ids = [aws_vpc_security_group_ingress_rule.test.security_group_rule_id]
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_revokeDefaultEgress(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupIngressRuleConfig_basic(rName), `
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id       = aws_security_group.test.id
+  security_group_rule_ids = [aws_vpc_security_group_ingress_rule.test.security_group_rule_id]
+  revoke_default_egress   = true
+}
+`)
+}
@@ -0,0 +1,717 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_eks_access_entries_exclusive", name="Access Entries Exclusive")
+func newAccessEntriesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &accessEntriesExclusiveResource{}, nil
+}
+
+const (
+	ResNameAccessEntriesExclusive = "Access Entries Exclusive"
+)
+
+type accessEntriesExclusiveResource struct {
+	framework.ResourceWithModel[accessEntriesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *accessEntriesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_entry": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[accessEntryExclusiveModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kubernetes_groups": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"principal_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrType: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(accessEntryType_Values()...),
+							},
+						},
+						names.AttrUserName: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"access_policy": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[accessPolicyExclusiveModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"policy_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"access_scope": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[accessScopeExclusiveModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeBetween(1, 1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"namespaces": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.AccessScopeType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *accessEntriesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan accessEntriesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := expandAccessEntriesExclusive(ctx, plan.AccessEntries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncAccessEntries(ctx, plan.ClusterName.ValueString(), want)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionCreating, ResNameAccessEntriesExclusive, plan.ClusterName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *accessEntriesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EKSClient(ctx)
+
+	var state accessEntriesExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := expandAccessEntriesExclusive(ctx, state.AccessEntries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findAccessEntriesExclusiveByClusterName(ctx, conn, state.ClusterName.ValueString(), accessEntryPrincipalARNs(prior))
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, ResNameAccessEntriesExclusive, state.ClusterName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.AccessEntries, diags = flattenAccessEntriesExclusive(ctx, out, prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *accessEntriesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state accessEntriesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AccessEntries.Equal(state.AccessEntries) {
+		want, diags := expandAccessEntriesExclusive(ctx, plan.AccessEntries)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncAccessEntries(ctx, plan.ClusterName.ValueString(), want)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EKS, create.ErrActionUpdating, ResNameAccessEntriesExclusive, plan.ClusterName.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncAccessEntries handles keeping the configured access entries and
+// their access policy associations in sync with the remote resource.
+//
+// Access entries defined on this resource but not present on the cluster
+// will be created. Access entries present on the cluster but not configured
+// on this resource will be deleted, except for the cluster creator's and
+// service-linked roles' entries. Access policy associations are synchronized
+// in the same way for each configured access entry.
+func (r *accessEntriesExclusiveResource) syncAccessEntries(ctx context.Context, clusterName string, want []accessEntryExclusive) error {
+	conn := r.Meta().EKSClient(ctx)
+
+	have, err := findAccessEntriesExclusiveByClusterName(ctx, conn, clusterName, accessEntryPrincipalARNs(want))
+	if err != nil {
+		return err
+	}
+
+	haveByPrincipalARN := make(map[string]accessEntryExclusive, len(have))
+	for _, v := range have {
+		haveByPrincipalARN[v.PrincipalARN] = v
+	}
+	wantPrincipalARNs := accessEntryPrincipalARNs(want)
+
+	for _, v := range have {
+		if slices.Contains(wantPrincipalARNs, v.PrincipalARN) {
+			continue
+		}
+
+		if err := deleteAccessEntry(ctx, conn, clusterName, v.PrincipalARN); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range want {
+		current, ok := haveByPrincipalARN[v.PrincipalARN]
+
+		// An access entry's type can't be changed.
+		if ok && current.Type != v.Type {
+			if err := deleteAccessEntry(ctx, conn, clusterName, v.PrincipalARN); err != nil {
+				return err
+			}
+			ok = false
+		}
+
+		if !ok {
+			input := &eks.CreateAccessEntryInput{
+				ClusterName:  aws.String(clusterName),
+				PrincipalArn: aws.String(v.PrincipalARN),
+				Type:         aws.String(v.Type),
+			}
+			if len(v.KubernetesGroups) > 0 {
+				input.KubernetesGroups = v.KubernetesGroups
+			}
+			if v.UserName != "" {
+				input.Username = aws.String(v.UserName)
+			}
+
+			_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidParameterException](ctx, propagationTimeout, func() (any, error) {
+				return conn.CreateAccessEntry(ctx, input)
+			}, "The specified principalArn is invalid: invalid principal")
+
+			if err != nil {
+				return fmt.Errorf("creating EKS Access Entry (%s): %w", accessEntryCreateResourceID(clusterName, v.PrincipalARN), err)
+			}
+
+			current = accessEntryExclusive{PrincipalARN: v.PrincipalARN, Type: v.Type}
+		} else if v.kubernetesGroupsChanged(current) || (v.UserName != "" && v.UserName != current.UserName) {
+			input := &eks.UpdateAccessEntryInput{
+				ClusterName:      aws.String(clusterName),
+				KubernetesGroups: v.KubernetesGroups,
+				PrincipalArn:     aws.String(v.PrincipalARN),
+			}
+			if v.UserName != "" {
+				input.Username = aws.String(v.UserName)
+			}
+
+			_, err := conn.UpdateAccessEntry(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("updating EKS Access Entry (%s): %w", accessEntryCreateResourceID(clusterName, v.PrincipalARN), err)
+			}
+		}
+
+		if err := syncAccessPolicies(ctx, conn, clusterName, v.PrincipalARN, current.AccessPolicies, v.AccessPolicies); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func syncAccessPolicies(ctx context.Context, conn *eks.Client, clusterName, principalARN string, have, want []accessPolicyExclusive) error {
+	for _, v := range have {
+		if slices.ContainsFunc(want, func(w accessPolicyExclusive) bool { return w.PolicyARN == v.PolicyARN }) {
+			continue
+		}
+
+		_, err := conn.DisassociateAccessPolicy(ctx, &eks.DisassociateAccessPolicyInput{
+			ClusterName:  aws.String(clusterName),
+			PolicyArn:    aws.String(v.PolicyARN),
+			PrincipalArn: aws.String(principalARN),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting EKS Access Policy Association (%s): %w", accessPolicyAssociationCreateResourceID(clusterName, principalARN, v.PolicyARN), err)
+		}
+	}
+
+	for _, v := range want {
+		// Associating an already associated access policy updates its access scope.
+		if slices.ContainsFunc(have, v.equal) {
+			continue
+		}
+
+		input := &eks.AssociateAccessPolicyInput{
+			AccessScope: &awstypes.AccessScope{
+				Namespaces: v.Namespaces,
+				Type:       awstypes.AccessScopeType(v.AccessScopeType),
+			},
+			ClusterName:  aws.String(clusterName),
+			PolicyArn:    aws.String(v.PolicyARN),
+			PrincipalArn: aws.String(principalARN),
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ResourceNotFoundException](ctx, propagationTimeout, func() (any, error) {
+			return conn.AssociateAccessPolicy(ctx, input)
+		}, "The specified principalArn could not be found")
+
+		if err != nil {
+			return fmt.Errorf("creating EKS Access Policy Association (%s): %w", accessPolicyAssociationCreateResourceID(clusterName, principalARN, v.PolicyARN), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *accessEntriesExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrClusterName), req, resp)
+}
+
+func deleteAccessEntry(ctx context.Context, conn *eks.Client, clusterName, principalARN string) error {
+	_, err := conn.DeleteAccessEntry(ctx, &eks.DeleteAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EKS Access Entry (%s): %w", accessEntryCreateResourceID(clusterName, principalARN), err)
+	}
+
+	return nil
+}
+
+// findAccessEntriesExclusiveByClusterName returns the cluster's access entries and their access policy associations.
+// The cluster creator's and service-linked roles' access entries are omitted unless their principal is one of the managed principals.
+func findAccessEntriesExclusiveByClusterName(ctx context.Context, conn *eks.Client, clusterName string, managedPrincipalARNs []string) ([]accessEntryExclusive, error) {
+	cluster, err := findClusterByName(ctx, conn, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+	}
+	principalARNs, err := findAccessEntries(ctx, conn, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*awstypes.AccessEntry
+	policies := make(map[string][]awstypes.AssociatedAccessPolicy, len(principalARNs))
+	for _, principalARN := range principalARNs {
+		entry, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+		if tfresource.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		input := &eks.ListAssociatedAccessPoliciesInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(principalARN),
+		}
+		entryPolicies, err := findAssociatedAccessPolicies(ctx, conn, input, tfslices.PredicateTrue[*awstypes.AssociatedAccessPolicy]())
+		if tfresource.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+		policies[principalARN] = entryPolicies
+	}
+
+	creatorPrincipalARN := accessEntryClusterCreatorPrincipalARN(cluster, entries, policies)
+
+	var output []accessEntryExclusive
+	for _, entry := range entries {
+		principalARN := aws.ToString(entry.PrincipalArn)
+		if !slices.Contains(managedPrincipalARNs, principalARN) && (principalARN == creatorPrincipalARN || accessEntryIsServiceLinked(principalARN)) {
+			continue
+		}
+
+		v := accessEntryExclusive{
+			KubernetesGroups: entry.KubernetesGroups,
+			PrincipalARN:     principalARN,
+			Type:             aws.ToString(entry.Type),
+			UserName:         aws.ToString(entry.Username),
+		}
+		for _, policy := range policies[principalARN] {
+			p := accessPolicyExclusive{
+				PolicyARN: aws.ToString(policy.PolicyArn),
+			}
+			if policy.AccessScope != nil {
+				p.AccessScopeType = string(policy.AccessScope.Type)
+				p.Namespaces = policy.AccessScope.Namespaces
+			}
+			v.AccessPolicies = append(v.AccessPolicies, p)
+		}
+
+		output = append(output, v)
+	}
+
+	return output, nil
+}
+
+func findAccessEntries(ctx context.Context, conn *eks.Client, input *eks.ListAccessEntriesInput) ([]string, error) {
+	var output []string
+
+	pages := eks.NewListAccessEntriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccessEntries...)
+	}
+
+	return output, nil
+}
+
+// accessEntryClusterCreatorPrincipalARN returns the principal ARN of the access entry EKS created for the cluster creator, if any.
+// The cluster creator's entry is created along with the cluster, before any other access entry can be created,
+// so it's the earliest created STANDARD access entry of a cluster that was created with admin permissions for its creator.
+// As the creator's entry may since have been removed, the earliest entry is only the creator's if it still has the
+// cluster-scoped AmazonEKSClusterAdminPolicy association that EKS bootstraps it with.
+func accessEntryClusterCreatorPrincipalARN(cluster *awstypes.Cluster, entries []*awstypes.AccessEntry, policies map[string][]awstypes.AssociatedAccessPolicy) string {
+	if cluster.AccessConfig == nil || !aws.ToBool(cluster.AccessConfig.BootstrapClusterCreatorAdminPermissions) {
+		return ""
+	}
+
+	var creator *awstypes.AccessEntry
+	for _, entry := range entries {
+		if aws.ToString(entry.Type) != accessEntryTypeStandard {
+			continue
+		}
+		if creator == nil || aws.ToTime(entry.CreatedAt).Before(aws.ToTime(creator.CreatedAt)) {
+			creator = entry
+		}
+	}
+
+	if creator == nil {
+		return ""
+	}
+
+	principalARN := aws.ToString(creator.PrincipalArn)
+	if !slices.ContainsFunc(policies[principalARN], func(v awstypes.AssociatedAccessPolicy) bool {
+		return strings.HasSuffix(aws.ToString(v.PolicyArn), accessPolicyClusterAdminARNSuffix) && v.AccessScope != nil && v.AccessScope.Type == awstypes.AccessScopeTypeCluster
+	}) {
+		return ""
+	}
+
+	return principalARN
+}
+
+// accessEntryIsServiceLinked returns whether the principal is an IAM service-linked role.
+func accessEntryIsServiceLinked(principalARN string) bool {
+	v, err := arn.Parse(principalARN)
+	if err != nil {
+		return false
+	}
+
+	return v.Service == "iam" && strings.HasPrefix(v.Resource, "role/aws-service-role/")
+}
+
+func accessEntryPrincipalARNs(entries []accessEntryExclusive) []string {
+	return tfslices.ApplyToAll(entries, func(v accessEntryExclusive) string {
+		return v.PrincipalARN
+	})
+}
+
+// accessEntryExclusive is the API-side representation of an access entry managed by aws_eks_access_entries_exclusive.
+type accessEntryExclusive struct {
+	AccessPolicies   []accessPolicyExclusive
+	KubernetesGroups []string
+	PrincipalARN     string
+	Type             string
+	UserName         string
+
+	// Whether kubernetes_groups is configured.
+	// Only the groups of STANDARD access entries are managed when it isn't.
+	kubernetesGroupsConfigured bool
+	typeConfigured             bool
+}
+
+func (v accessEntryExclusive) kubernetesGroupsChanged(current accessEntryExclusive) bool {
+	if !v.kubernetesGroupsConfigured && v.Type != accessEntryTypeStandard {
+		return false
+	}
+
+	return !stringSetsEqual(v.KubernetesGroups, current.KubernetesGroups)
+}
+
+type accessPolicyExclusive struct {
+	AccessScopeType string
+	Namespaces      []string
+	PolicyARN       string
+}
+
+func (v accessPolicyExclusive) equal(o accessPolicyExclusive) bool {
+	return v.PolicyARN == o.PolicyARN && v.AccessScopeType == o.AccessScopeType && stringSetsEqual(v.Namespaces, o.Namespaces)
+}
+
+func stringSetsEqual(s1, s2 []string) bool {
+	s1, s2 = slices.Clone(s1), slices.Clone(s2)
+	slices.Sort(s1)
+	slices.Sort(s2)
+
+	return slices.Equal(slices.Compact(s1), slices.Compact(s2))
+}
+
+func expandAccessEntriesExclusive(ctx context.Context, v fwtypes.SetNestedObjectValueOf[accessEntryExclusiveModel]) ([]accessEntryExclusive, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	entries, d := v.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var output []accessEntryExclusive
+	for _, entry := range entries {
+		apiObject := accessEntryExclusive{
+			KubernetesGroups:           flex.ExpandFrameworkStringValueSet(ctx, entry.KubernetesGroups),
+			PrincipalARN:               entry.PrincipalARN.ValueString(),
+			Type:                       entry.Type.ValueString(),
+			UserName:                   entry.UserName.ValueString(),
+			kubernetesGroupsConfigured: !entry.KubernetesGroups.IsNull(),
+			typeConfigured:             !entry.Type.IsNull(),
+		}
+		if apiObject.Type == "" {
+			apiObject.Type = accessEntryTypeStandard
+		}
+
+		policies, d := entry.AccessPolicies.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, policy := range policies {
+			p := accessPolicyExclusive{
+				PolicyARN: policy.PolicyARN.ValueString(),
+			}
+
+			scope, d := policy.AccessScope.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			if scope != nil {
+				p.AccessScopeType = scope.Type.ValueString()
+				p.Namespaces = flex.ExpandFrameworkStringValueSet(ctx, scope.Namespaces)
+			}
+
+			apiObject.AccessPolicies = append(apiObject.AccessPolicies, p)
+		}
+
+		output = append(output, apiObject)
+	}
+
+	return output, diags
+}
+
+// flattenAccessEntriesExclusive converts the cluster's access entries to their Terraform representation.
+// Values that EKS defaults when they aren't specified, i.e. the type, user name and the groups of non-STANDARD entries,
+// are left null when they are null in the prior state or, for imported entries, match EKS' defaults.
+func flattenAccessEntriesExclusive(ctx context.Context, apiObjects []accessEntryExclusive, prior []accessEntryExclusive) (fwtypes.SetNestedObjectValueOf[accessEntryExclusiveModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorByPrincipalARN := make(map[string]accessEntryExclusive, len(prior))
+	for _, v := range prior {
+		priorByPrincipalARN[v.PrincipalARN] = v
+	}
+
+	var entries []*accessEntryExclusiveModel
+	for _, apiObject := range apiObjects {
+		p, hasPrior := priorByPrincipalARN[apiObject.PrincipalARN]
+
+		entry := &accessEntryExclusiveModel{
+			KubernetesGroups: flex.FlattenFrameworkStringValueSetOfString(ctx, apiObject.KubernetesGroups),
+			PrincipalARN:     fwtypes.ARNValue(apiObject.PrincipalARN),
+			Type:             types.StringValue(apiObject.Type),
+			UserName:         types.StringValue(apiObject.UserName),
+		}
+
+		if apiObject.Type == accessEntryTypeStandard && (!hasPrior || !p.typeConfigured) {
+			entry.Type = types.StringNull()
+		}
+		if (hasPrior && p.UserName == "") || (!hasPrior && apiObject.UserName == accessEntryDefaultUserName(apiObject)) {
+			entry.UserName = types.StringNull()
+		}
+		if apiObject.Type != accessEntryTypeStandard && (!hasPrior || !p.kubernetesGroupsConfigured) {
+			entry.KubernetesGroups = fwtypes.NewSetValueOfNull[types.String](ctx)
+		}
+
+		var policies []*accessPolicyExclusiveModel
+		for _, v := range apiObject.AccessPolicies {
+			policies = append(policies, &accessPolicyExclusiveModel{
+				AccessScope: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &accessScopeExclusiveModel{
+					Namespaces: flex.FlattenFrameworkStringValueSetOfString(ctx, v.Namespaces),
+					Type:       fwtypes.StringEnumValue(awstypes.AccessScopeType(v.AccessScopeType)),
+				}),
+				PolicyARN: fwtypes.ARNValue(v.PolicyARN),
+			})
+		}
+		entry.AccessPolicies = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, policies)
+
+		entries = append(entries, entry)
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, entries), diags
+}
+
+// accessEntryDefaultUserName returns the Kubernetes user name EKS assigns to a STANDARD access entry when none is specified.
+// See https://docs.aws.amazon.com/eks/latest/userguide/creating-access-entries.html.
+func accessEntryDefaultUserName(v accessEntryExclusive) string {
+	if v.Type != accessEntryTypeStandard {
+		return v.UserName
+	}
+
+	principalARN, err := arn.Parse(v.PrincipalARN)
+	if err != nil {
+		return ""
+	}
+
+	if roleName, ok := strings.CutPrefix(principalARN.Resource, "role/"); ok {
+		roleName = roleName[strings.LastIndex(roleName, "/")+1:]
+		return arn.ARN{
+			Partition: principalARN.Partition,
+			Service:   "sts",
+			AccountID: principalARN.AccountID,
+			Resource:  "assumed-role/" + roleName + "/{{SessionName}}",
+		}.String()
+	}
+
+	return v.PrincipalARN
+}
+
+type accessEntriesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	AccessEntries fwtypes.SetNestedObjectValueOf[accessEntryExclusiveModel] `tfsdk:"access_entry"`
+	ClusterName   types.String                                              `tfsdk:"cluster_name"`
+}
+
+type accessEntryExclusiveModel struct {
+	AccessPolicies   fwtypes.SetNestedObjectValueOf[accessPolicyExclusiveModel] `tfsdk:"access_policy"`
+	KubernetesGroups fwtypes.SetOfString                                        `tfsdk:"kubernetes_groups"`
+	PrincipalARN     fwtypes.ARN                                                `tfsdk:"principal_arn"`
+	Type             types.String                                               `tfsdk:"type"`
+	UserName         types.String                                               `tfsdk:"user_name"`
+}
+
+type accessPolicyExclusiveModel struct {
+	AccessScope fwtypes.ListNestedObjectValueOf[accessScopeExclusiveModel] `tfsdk:"access_scope"`
+	PolicyARN   fwtypes.ARN                                                `tfsdk:"policy_arn"`
+}
+
+type accessScopeExclusiveModel struct {
+	Namespaces fwtypes.SetOfString                          `tfsdk:"namespaces"`
+	Type       fwtypes.StringEnum[awstypes.AccessScopeType] `tfsdk:"type"`
+}
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccessEntryClusterCreatorPrincipalARN(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	entries := []*awstypes.AccessEntry{
+		{PrincipalArn: aws.String("arn:aws:iam::123456789012:role/admin"), Type: aws.String("STANDARD"), CreatedAt: aws.Time(now.Add(time.Hour))},
+		{PrincipalArn: aws.String("arn:aws:iam::123456789012:role/node"), Type: aws.String("EC2_LINUX"), CreatedAt: aws.Time(now.Add(-time.Hour))},
+		{PrincipalArn: aws.String("arn:aws:iam::123456789012:role/creator"), Type: aws.String("STANDARD"), CreatedAt: aws.Time(now)},
+	}
+	clusterAdmin := func(scopeType awstypes.AccessScopeType) map[string][]awstypes.AssociatedAccessPolicy {
+		return map[string][]awstypes.AssociatedAccessPolicy{
+			"arn:aws:iam::123456789012:role/creator": {
+				{PolicyArn: aws.String("arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"), AccessScope: &awstypes.AccessScope{Type: awstypes.AccessScopeTypeCluster}},
+				{PolicyArn: aws.String("arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"), AccessScope: &awstypes.AccessScope{Type: scopeType}},
+			},
+		}
+	}
+	bootstrap := &awstypes.AccessConfigResponse{BootstrapClusterCreatorAdminPermissions: aws.Bool(true)}
+
+	testCases := map[string]struct {
+		accessConfig *awstypes.AccessConfigResponse
+		policies     map[string][]awstypes.AssociatedAccessPolicy
+		expected     string
+	}{
+		"no access config": {
+			policies: clusterAdmin(awstypes.AccessScopeTypeCluster),
+		},
+		"bootstrap disabled": {
+			accessConfig: &awstypes.AccessConfigResponse{BootstrapClusterCreatorAdminPermissions: aws.Bool(false)},
+			policies:     clusterAdmin(awstypes.AccessScopeTypeCluster),
+		},
+		"bootstrap enabled": {
+			accessConfig: bootstrap,
+			policies:     clusterAdmin(awstypes.AccessScopeTypeCluster),
+			expected:     "arn:aws:iam::123456789012:role/creator",
+		},
+		"no cluster admin association": {
+			accessConfig: bootstrap,
+		},
+		"namespace scoped cluster admin association": {
+			accessConfig: bootstrap,
+			policies:     clusterAdmin(awstypes.AccessScopeTypeNamespace),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfeks.AccessEntryClusterCreatorPrincipalARN(&awstypes.Cluster{AccessConfig: testCase.accessConfig}, entries, testCase.policies)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccessEntryIsServiceLinked(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"arn:aws:iam::123456789012:role/aws-service-role/eks.amazonaws.com/AWSServiceRoleForAmazonEKS": true,
+		"arn:aws:iam::123456789012:role/aws-service-role-lookalike":                                    false,
+		"arn:aws:iam::123456789012:role/admin":                                                         false,
+		"arn:aws:iam::123456789012:user/aws-service-role/test":                                         false,
+		"not-an-arn": false,
+	}
+
+	for principalARN, expected := range testCases {
+		if got := tfeks.AccessEntryIsServiceLinked(principalARN); got != expected {
+			t.Errorf("%s: expected %t, got %t", principalARN, expected, got)
+		}
+	}
+}
+
+func TestAccEKSAccessEntriesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+	clusterResourceName := "aws_eks_cluster.test"
+	userResourceName := "aws_iam_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterName, clusterResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "access_entry.*.principal_arn", userResourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_entry.*", map[string]string{
+						"access_policy.#":                     "1",
+						"access_policy.0.access_scope.#":      "1",
+						"access_policy.0.access_scope.0.type": "cluster",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrClusterName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrClusterName,
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessEntriesExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "1"),
+				),
+			},
+			{
+				Config: testAccAccessEntriesExclusiveConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_entry.*", map[string]string{
+						"kubernetes_groups.#":                         "1",
+						"kubernetes_groups.0":                         "viewers",
+						names.AttrUserName:                            "test",
+						"access_policy.#":                             "1",
+						"access_policy.0.access_scope.0.type":         "namespace",
+						"access_policy.0.access_scope.0.namespaces.#": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccAccessEntriesExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// An access entry added out of band should be removed
+func TestAccEKSAccessEntriesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_outOfBandAddition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName),
+					testAccCheckAccessEntriesExclusiveCreateAccessEntry(ctx, resourceName, "aws_iam_user.oob"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAccessEntriesExclusiveConfig_outOfBandAddition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessEntriesExclusiveExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.EKS, create.ErrActionCheckingExistence, tfeks.ResNameAccessEntriesExclusive, name, errors.New("not found"))
+		}
+
+		clusterName := rs.Primary.Attributes[names.AttrClusterName]
+		if clusterName == "" {
+			return create.Error(names.EKS, create.ErrActionCheckingExistence, tfeks.ResNameAccessEntriesExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+		out, err := tfeks.FindAccessEntriesExclusiveByClusterName(ctx, conn, clusterName, nil)
+		if err != nil {
+			return create.Error(names.EKS, create.ErrActionCheckingExistence, tfeks.ResNameAccessEntriesExclusive, clusterName, err)
+		}
+
+		entryCount := rs.Primary.Attributes["access_entry.#"]
+		if entryCount != strconv.Itoa(len(out)) {
+			return create.Error(names.EKS, create.ErrActionCheckingExistence, tfeks.ResNameAccessEntriesExclusive, clusterName, errors.New("unexpected access_entry count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessEntriesExclusiveCreateAccessEntry(ctx context.Context, name, principalName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		principal, ok := s.RootModule().Resources[principalName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", principalName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+		_, err := conn.CreateAccessEntry(ctx, &eks.CreateAccessEntryInput{
+			ClusterName:  aws.String(rs.Primary.Attributes[names.AttrClusterName]),
+			PrincipalArn: aws.String(principal.Primary.Attributes[names.AttrARN]),
+		})
+
+		return err
+	}
+}
+
+func testAccAccessEntriesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  access_entry {
+    principal_arn = aws_iam_user.test.arn
+
+    access_policy {
+      policy_arn = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+      access_scope {
+        type = "cluster"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAccessEntriesExclusiveConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_user" "test2" {
+  name = "%[1]s-2"
+}
+
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  access_entry {
+    principal_arn = aws_iam_user.test.arn
+  }
+
+  access_entry {
+    principal_arn     = aws_iam_user.test2.arn
+    kubernetes_groups = ["viewers"]
+    user_name         = "test"
+
+    access_policy {
+      policy_arn = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSEditPolicy"
+
+      access_scope {
+        type       = "namespace"
+        namespaces = ["default", "test"]
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAccessEntriesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_base(rName), `
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name = aws_eks_cluster.test.name
+}
+`)
+}
+
+func testAccAccessEntriesExclusiveConfig_outOfBandAddition(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_user" "oob" {
+  name = "%[1]s-oob"
+}
+
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  access_entry {
+    principal_arn = aws_iam_user.test.arn
+  }
+}
+`, rName))
+}
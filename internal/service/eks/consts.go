@@ -34,6 +34,12 @@ const (
 	accessEntryTypeStandard     = "STANDARD"
 )
 
+// The ARN suffix of the access policy EKS associates with the cluster creator's access entry, e.g.
+// `arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy`.
+const (
+	accessPolicyClusterAdminARNSuffix = ":cluster-access-policy/AmazonEKSClusterAdminPolicy"
+)
+
 func accessEntryType_Values() []string {
 	return []string{
 		accessEntryTypeEC2,
@@ -14,7 +14,10 @@ var (
 	ResourceNodeGroup               = resourceNodeGroup
 	ResourcePodIdentityAssociation  = newPodIdentityAssociationResource
 
+	AccessEntryClusterCreatorPrincipalARN      = accessEntryClusterCreatorPrincipalARN
+	AccessEntryIsServiceLinked                 = accessEntryIsServiceLinked
 	ClusterStateUpgradeV0                      = clusterStateUpgradeV0
+	FindAccessEntriesExclusiveByClusterName    = findAccessEntriesExclusiveByClusterName
 	FindAccessEntryByTwoPartKey                = findAccessEntryByTwoPartKey
 	FindAccessPolicyAssociationByThreePartKey  = findAccessPolicyAssociationByThreePartKey
 	FindAddonByTwoPartKey                      = findAddonByTwoPartKey
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+type kubeconfigAuthentication string
+
+const (
+	kubeconfigAuthenticationExec  kubeconfigAuthentication = "exec"
+	kubeconfigAuthenticationToken kubeconfigAuthentication = "token"
+)
+
+func (kubeconfigAuthentication) Values() []kubeconfigAuthentication {
+	return []kubeconfigAuthentication{
+		kubeconfigAuthenticationExec,
+		kubeconfigAuthenticationToken,
+	}
+}
+
+const (
+	kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	kubeconfigExecCommand    = "aws"
+)
+
+type kubeconfigModel struct {
+	framework.WithRegionModel
+	Authentication fwtypes.StringEnum[kubeconfigAuthentication]            `tfsdk:"authentication"`
+	Clusters       fwtypes.ListNestedObjectValueOf[kubeconfigClusterModel] `tfsdk:"cluster"`
+	CurrentContext types.String                                            `tfsdk:"current_context"`
+	Kubeconfig     types.String                                            `tfsdk:"kubeconfig"`
+	Profile        types.String                                            `tfsdk:"profile"`
+}
+
+type kubeconfigClusterModel struct {
+	ContextName types.String `tfsdk:"context_name"`
+	Name        types.String `tfsdk:"name"`
+	Namespace   types.String `tfsdk:"namespace"`
+	RoleARN     fwtypes.ARN  `tfsdk:"role_arn"`
+}
+
+// kubeconfig is a Kubernetes client configuration file.
+// See https://kubernetes.io/docs/reference/config-api/kubeconfig.v1/.
+type kubeconfig struct {
+	APIVersion     string                   `yaml:"apiVersion"`
+	Kind           string                   `yaml:"kind"`
+	Clusters       []kubeconfigNamedCluster `yaml:"clusters"`
+	Contexts       []kubeconfigNamedContext `yaml:"contexts"`
+	CurrentContext string                   `yaml:"current-context"`
+	Preferences    struct{}                 `yaml:"preferences"`
+	Users          []kubeconfigNamedUser    `yaml:"users"`
+}
+
+type kubeconfigNamedCluster struct {
+	Name    string            `yaml:"name"`
+	Cluster kubeconfigCluster `yaml:"cluster"`
+}
+
+type kubeconfigCluster struct {
+	// CertificateAuthorityData is base64-encoded, exactly as returned by DescribeCluster.
+	CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	Server                   string `yaml:"server"`
+}
+
+type kubeconfigNamedContext struct {
+	Name    string            `yaml:"name"`
+	Context kubeconfigContext `yaml:"context"`
+}
+
+type kubeconfigContext struct {
+	Cluster   string `yaml:"cluster"`
+	Namespace string `yaml:"namespace,omitempty"`
+	User      string `yaml:"user"`
+}
+
+type kubeconfigNamedUser struct {
+	Name string         `yaml:"name"`
+	User kubeconfigUser `yaml:"user"`
+}
+
+type kubeconfigUser struct {
+	Exec  *kubeconfigExecConfig `yaml:"exec,omitempty"`
+	Token string                `yaml:"token,omitempty"`
+}
+
+type kubeconfigExecConfig struct {
+	APIVersion      string                 `yaml:"apiVersion"`
+	Args            []string               `yaml:"args"`
+	Command         string                 `yaml:"command"`
+	Env             []kubeconfigExecEnvVar `yaml:"env,omitempty"`
+	InteractiveMode string                 `yaml:"interactiveMode"`
+}
+
+type kubeconfigExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// renderKubeconfig returns a kubeconfig document with a cluster, context and user for each of the configured EKS clusters.
+// Users either run `aws eks get-token` or, for the "token" authentication mode, have a static authentication token valid for 15 minutes.
+func renderKubeconfig(ctx context.Context, c *conns.AWSClient, data *kubeconfigModel) (string, error) {
+	conn := c.EKSClient(ctx)
+	region := c.Region(ctx)
+
+	clusters, diags := data.Clusters.ToSlice(ctx)
+	if diags.HasError() {
+		return "", fmt.Errorf("reading cluster configuration: %v", diags)
+	}
+
+	authentication := data.Authentication.ValueEnum()
+	if authentication == "" {
+		authentication = kubeconfigAuthenticationExec
+	}
+
+	config := kubeconfig{
+		APIVersion: "v1",
+		Kind:       "Config",
+	}
+	contextNames := make(map[string]struct{})
+
+	for _, v := range clusters {
+		name := v.Name.ValueString()
+		contextName := v.ContextName.ValueString()
+		if contextName == "" {
+			contextName = name
+		}
+		if _, ok := contextNames[contextName]; ok {
+			return "", fmt.Errorf("duplicate context name (%s)", contextName)
+		}
+		contextNames[contextName] = struct{}{}
+
+		cluster, err := findClusterByName(ctx, conn, name)
+		if err != nil {
+			return "", fmt.Errorf("reading EKS Cluster (%s): %w", name, err)
+		}
+
+		if aws.ToString(cluster.Endpoint) == "" || cluster.CertificateAuthority == nil || aws.ToString(cluster.CertificateAuthority.Data) == "" {
+			return "", fmt.Errorf("EKS Cluster (%s) has no endpoint or certificate authority, is it still being created?", name)
+		}
+
+		// Local clusters on Outposts are identified by their ID rather than their name.
+		clusterIDFlag, clusterID := "--cluster-name", name
+		if cluster.OutpostConfig != nil && aws.ToString(cluster.Id) != "" {
+			clusterIDFlag, clusterID = "--cluster-id", aws.ToString(cluster.Id)
+		}
+
+		var user kubeconfigUser
+		switch authentication {
+		case kubeconfigAuthenticationExec:
+			args := []string{"--region", region, "eks", "get-token", clusterIDFlag, clusterID, "--output", "json"}
+			if roleARN := v.RoleARN.ValueString(); roleARN != "" {
+				args = append(args, "--role-arn", roleARN)
+			}
+
+			exec := &kubeconfigExecConfig{
+				APIVersion:      kubeconfigExecAPIVersion,
+				Args:            args,
+				Command:         kubeconfigExecCommand,
+				InteractiveMode: "IfAvailable",
+			}
+			if profile := data.Profile.ValueString(); profile != "" {
+				exec.Env = []kubeconfigExecEnvVar{{Name: "AWS_PROFILE", Value: profile}}
+			}
+
+			user.Exec = exec
+		case kubeconfigAuthenticationToken:
+			stsConn := c.STSClient(ctx)
+			if roleARN := v.RoleARN.ValueString(); roleARN != "" {
+				credentials := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsConn, roleARN))
+				stsConn = sts.New(stsConn.Options(), func(o *sts.Options) {
+					o.Credentials = credentials
+				})
+			}
+
+			generator, err := NewGenerator(false, false)
+			if err != nil {
+				return "", err
+			}
+
+			token, err := generator.GetWithSTS(ctx, clusterID, stsConn)
+			if err != nil {
+				return "", fmt.Errorf("generating EKS Cluster (%s) authentication token: %w", name, err)
+			}
+
+			user.Token = token.Token
+		}
+
+		config.Clusters = append(config.Clusters, kubeconfigNamedCluster{
+			Name: contextName,
+			Cluster: kubeconfigCluster{
+				CertificateAuthorityData: aws.ToString(cluster.CertificateAuthority.Data),
+				Server:                   aws.ToString(cluster.Endpoint),
+			},
+		})
+		config.Contexts = append(config.Contexts, kubeconfigNamedContext{
+			Name: contextName,
+			Context: kubeconfigContext{
+				Cluster:   contextName,
+				Namespace: v.Namespace.ValueString(),
+				User:      contextName,
+			},
+		})
+		config.Users = append(config.Users, kubeconfigNamedUser{
+			Name: contextName,
+			User: user,
+		})
+	}
+
+	config.CurrentContext = data.CurrentContext.ValueString()
+	if config.CurrentContext == "" && len(config.Contexts) > 0 {
+		config.CurrentContext = config.Contexts[0].Name
+	}
+	if _, ok := contextNames[config.CurrentContext]; !ok {
+		return "", fmt.Errorf("current context (%s) is not one of the configured contexts", config.CurrentContext)
+	}
+
+	return marshalKubeconfig(&config)
+}
+
+func marshalKubeconfig(config *kubeconfig) (string, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(config); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
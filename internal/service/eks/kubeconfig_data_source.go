@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_eks_kubeconfig", name="Kubeconfig")
+func newKubeconfigDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &kubeconfigDataSource{}, nil
+}
+
+const (
+	DSNameKubeconfig = "Kubeconfig Data Source"
+)
+
+const errKubeconfigDataSourceTokenAuthentication = `"token" authentication is only supported by the aws_eks_kubeconfig ephemeral resource, as the data source would store the token in the Terraform state.`
+
+type kubeconfigDataSource struct {
+	framework.DataSourceWithModel[kubeconfigModel]
+}
+
+func (d *kubeconfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authentication": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[kubeconfigAuthentication](),
+				Optional:   true,
+			},
+			"current_context": schema.StringAttribute{
+				Optional: true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrProfile: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cluster": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kubeconfigClusterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"context_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrNamespace: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects "token" authentication, as the data source would persist the short-lived token in state and plan output.
+func (d *kubeconfigDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var authentication fwtypes.StringEnum[kubeconfigAuthentication]
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authentication"), &authentication)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if authentication.ValueEnum() == kubeconfigAuthenticationToken {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("authentication"),
+			"Invalid Attribute Value",
+			errKubeconfigDataSourceTokenAuthentication,
+		)
+	}
+}
+
+func (d *kubeconfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data kubeconfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The authentication attribute may have been unknown during validation.
+	if data.Authentication.ValueEnum() == kubeconfigAuthenticationToken {
+		resp.Diagnostics.AddAttributeError(path.Root("authentication"), "Invalid Attribute Value", errKubeconfigDataSourceTokenAuthentication)
+		return
+	}
+
+	kubeconfig, err := renderKubeconfig(ctx, d.Meta(), &data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, DSNameKubeconfig, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Kubeconfig = types.StringValue(kubeconfig)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`current-context: `+rName+`\n`)),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`- get-token\n\s+- --cluster-name\n\s+- `+rName+`\n`)),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`namespace: test\n`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSKubeconfigDataSource_token(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubeconfigDataSourceConfig_token(rName),
+				ExpectError: regexache.MustCompile(`"token" authentication is only supported by the aws_eks_kubeconfig ephemeral`),
+			},
+		},
+	})
+}
+
+func testAccKubeconfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_eks_kubeconfig" "test" {
+  cluster {
+    name      = aws_eks_cluster.test.name
+    namespace = "test"
+  }
+}
+`)
+}
+
+func testAccKubeconfigDataSourceConfig_token(rName string) string {
+	return fmt.Sprintf(`
+data "aws_eks_kubeconfig" "test" {
+  authentication = "token"
+
+  cluster {
+    name = %[1]q
+  }
+}
+`, rName)
+}
@@ -26,6 +26,12 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.Ser
 			Name:     "ClusterAuth",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newKubeconfigEphemeralResource,
+			TypeName: "aws_eks_kubeconfig",
+			Name:     "Kubeconfig",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -37,11 +43,23 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Cluster Versions",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newKubeconfigDataSource,
+			TypeName: "aws_eks_kubeconfig",
+			Name:     "Kubeconfig",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newAccessEntriesExclusiveResource,
+			TypeName: "aws_eks_access_entries_exclusive",
+			Name:     "Access Entries Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newPodIdentityAssociationResource,
 			TypeName: "aws_eks_pod_identity_association",
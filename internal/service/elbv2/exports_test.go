@@ -14,20 +14,21 @@ var (
 	ResourceTrustStore            = resourceTrustStore
 	ResourceTrustStoreRevocation  = resourceTrustStoreRevocation
 
-	FindListenerByARN                    = findListenerByARN
-	FindListenerCertificateByTwoPartKey  = findListenerCertificateByTwoPartKey
-	FindListenerRuleByARN                = findListenerRuleByARN
-	FindLoadBalancerAttributesByARN      = findLoadBalancerAttributesByARN
-	FindLoadBalancerByARN                = findLoadBalancerByARN
-	FindTargetHealthDescription          = findTargetHealthDescription
-	FindTrustStoreByARN                  = findTrustStoreByARN
-	FindTrustStoreRevocationByTwoPartKey = findTrustStoreRevocationByTwoPartKey
-	HealthCheckProtocolEnumValues        = healthCheckProtocolEnumValues
-	HostedZoneIDPerRegionALBMap          = hostedZoneIDPerRegionALBMap
-	HostedZoneIDPerRegionNLBMap          = hostedZoneIDPerRegionNLBMap
-	ListenerARNFromRuleARN               = listenerARNFromRuleARN
-	ProtocolVersionEnumValues            = protocolVersionEnumValues
-	SuffixFromARN                        = suffixFromARN
+	FindListenerByARN                       = findListenerByARN
+	FindListenerCertificateByTwoPartKey     = findListenerCertificateByTwoPartKey
+	FindListenerRuleByARN                   = findListenerRuleByARN
+	FindListenerRulesExclusiveByListenerARN = findListenerRulesExclusiveByListenerARN
+	FindLoadBalancerAttributesByARN         = findLoadBalancerAttributesByARN
+	FindLoadBalancerByARN                   = findLoadBalancerByARN
+	FindTargetHealthDescription             = findTargetHealthDescription
+	FindTrustStoreByARN                     = findTrustStoreByARN
+	FindTrustStoreRevocationByTwoPartKey    = findTrustStoreRevocationByTwoPartKey
+	HealthCheckProtocolEnumValues           = healthCheckProtocolEnumValues
+	HostedZoneIDPerRegionALBMap             = hostedZoneIDPerRegionALBMap
+	HostedZoneIDPerRegionNLBMap             = hostedZoneIDPerRegionNLBMap
+	ListenerARNFromRuleARN                  = listenerARNFromRuleARN
+	ProtocolVersionEnumValues               = protocolVersionEnumValues
+	SuffixFromARN                           = suffixFromARN
 )
 
 const (
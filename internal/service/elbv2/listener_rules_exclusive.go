@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lb_listener_rules_exclusive", name="Listener Rules Exclusive")
+func newListenerRulesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &listenerRulesExclusiveResource{}, nil
+}
+
+const (
+	ResNameListenerRulesExclusive = "Listener Rules Exclusive"
+)
+
+type listenerRulesExclusiveResource struct {
+	framework.ResourceWithModel[listenerRulesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *listenerRulesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"listener_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[listenerRuleExclusiveModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrPriority: schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.Between(listenerRulePriorityMin, listenerRulePriorityMax),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig flags rules which are declared more than once, rules whose priorities collide
+// and rules which are not declared in ascending priority order.
+func (r *listenerRulesExclusiveResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data listenerRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return
+	}
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arns := make(map[string]int)
+	priorities := make(map[int32]int)
+	var last int32
+	for i, rule := range rules {
+		rulePath := path.Root(names.AttrRule).AtListIndex(i)
+
+		if arn := rule.ARN; !arn.IsNull() && !arn.IsUnknown() {
+			if j, ok := arns[arn.ValueString()]; ok {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName(names.AttrARN), "Duplicate Listener Rule",
+					fmt.Sprintf("Listener Rule (%s) is declared by both rule %d and rule %d.", arn.ValueString(), j, i))
+			}
+			arns[arn.ValueString()] = i
+		}
+
+		if priority := rule.Priority; !priority.IsNull() && !priority.IsUnknown() {
+			v := priority.ValueInt32()
+
+			if j, ok := priorities[v]; ok {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName(names.AttrPriority), "Listener Rule Priority Collision",
+					fmt.Sprintf("Priority %d is assigned to both rule %d and rule %d.", v, j, i))
+			} else if v < last {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName(names.AttrPriority), "Listener Rules Out Of Order",
+					fmt.Sprintf("Rules must be declared in ascending priority order, but rule %d has priority %d which is lower than %d.", i, v, last))
+			}
+			priorities[v] = i
+			last = max(last, v)
+		}
+	}
+}
+
+func (r *listenerRulesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan listenerRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncRules(ctx, &plan, create.ErrActionCreating)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *listenerRulesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().ELBV2Client(ctx)
+
+	var state listenerRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listenerARN := state.ListenerARN.ValueString()
+	out, err := findListenerRulesExclusiveByListenerARN(ctx, conn, listenerARN)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ELBV2, create.ErrActionReading, ResNameListenerRulesExclusive, listenerARN, err),
+			err.Error(),
+		)
+		return
+	}
+
+	rules := make([]*listenerRuleExclusiveModel, 0, len(out))
+	for _, rule := range out {
+		rules = append(rules, &listenerRuleExclusiveModel{
+			ARN:      fwtypes.ARNValue(aws.ToString(rule.RuleArn)),
+			Priority: types.Int32Value(flex.StringToInt32Value(rule.Priority)),
+		})
+	}
+
+	state.Rules = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, rules)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *listenerRulesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state listenerRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Rules.Equal(state.Rules) {
+		resp.Diagnostics.Append(r.syncRules(ctx, &plan, create.ErrActionUpdating)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured listener rules in sync
+// with the remote resource.
+//
+// Rules of the listener not configured on this resource, other than the
+// default rule, will be deleted. The priorities of the remaining rules are
+// then set in a single SetRulePriorities call so that rules can swap
+// priorities without colliding. A warning describing each deleted rule is
+// returned so that the removed drift is visible on every apply.
+func (r *listenerRulesExclusiveResource) syncRules(ctx context.Context, plan *listenerRulesExclusiveResourceModel, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().ELBV2Client(ctx)
+
+	listenerARN := plan.ListenerARN.ValueString()
+	have, err := findListenerRulesExclusiveByListenerARN(ctx, conn, listenerARN)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.ELBV2, action, ResNameListenerRulesExclusive, listenerARN, err),
+			err.Error(),
+		)
+		return diags
+	}
+
+	want, d := plan.Rules.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current := make(map[string]string, len(have))
+	for _, rule := range have {
+		current[aws.ToString(rule.RuleArn)] = aws.ToString(rule.Priority)
+	}
+
+	var pairs []awstypes.RulePriorityPair
+	for _, rule := range want {
+		arn, priority := rule.ARN.ValueString(), rule.Priority.ValueInt32()
+
+		v, ok := current[arn]
+		if !ok {
+			err := fmt.Errorf("Listener Rule (%s) not found on Listener (%s)", arn, listenerARN)
+			diags.AddError(
+				create.ProblemStandardMessage(names.ELBV2, action, ResNameListenerRulesExclusive, listenerARN, err),
+				err.Error(),
+			)
+			return diags
+		}
+
+		if flex.StringToInt32Value(&v) != priority {
+			pairs = append(pairs, awstypes.RulePriorityPair{
+				Priority: aws.Int32(priority),
+				RuleArn:  aws.String(arn),
+			})
+		}
+	}
+
+	var deleted []awstypes.Rule
+	for _, rule := range have {
+		arn := aws.ToString(rule.RuleArn)
+		if slices.ContainsFunc(want, func(v *listenerRuleExclusiveModel) bool { return v.ARN.ValueString() == arn }) {
+			continue
+		}
+
+		input := elasticloadbalancingv2.DeleteRuleInput{
+			RuleArn: aws.String(arn),
+		}
+		_, err := conn.DeleteRule(ctx, &input)
+
+		if errs.IsA[*awstypes.RuleNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(
+				create.ProblemStandardMessage(names.ELBV2, action, ResNameListenerRulesExclusive, listenerARN, err),
+				fmt.Sprintf("deleting Listener Rule (%s): %s", arn, err),
+			)
+			return diags
+		}
+
+		deleted = append(deleted, rule)
+	}
+
+	if len(pairs) > 0 {
+		input := elasticloadbalancingv2.SetRulePrioritiesInput{
+			RulePriorities: pairs,
+		}
+		_, err := conn.SetRulePriorities(ctx, &input)
+
+		if err != nil {
+			diags.AddError(
+				create.ProblemStandardMessage(names.ELBV2, action, ResNameListenerRulesExclusive, listenerARN, err),
+				fmt.Sprintf("setting rule priorities: %s", err),
+			)
+			return diags
+		}
+	}
+
+	if len(deleted) > 0 {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "The following rules of Listener (%s) were not configured and have been deleted:\n", listenerARN)
+		for _, rule := range deleted {
+			fmt.Fprintf(&sb, "\n  - %s (priority %s)", aws.ToString(rule.RuleArn), aws.ToString(rule.Priority))
+		}
+
+		diags.AddWarning(fmt.Sprintf("Deleted %d unmanaged Listener Rule(s)", len(deleted)), sb.String())
+	}
+
+	return diags
+}
+
+func (r *listenerRulesExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("listener_arn"), req, resp)
+}
+
+// findListenerRulesExclusiveByListenerARN returns the non-default rules of the specified listener, in priority order.
+func findListenerRulesExclusiveByListenerARN(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) ([]awstypes.Rule, error) {
+	// DescribeRules returns ListenerNotFoundException rather than an empty result for a listener that doesn't exist.
+	if _, err := findListenerByARN(ctx, conn, arn); err != nil {
+		return nil, err
+	}
+
+	input := elasticloadbalancingv2.DescribeRulesInput{
+		ListenerArn: aws.String(arn),
+	}
+	output, err := findListenerRules(ctx, conn, &input, func(v *awstypes.Rule) bool {
+		return !aws.ToBool(v.IsDefault)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(output, func(a, b awstypes.Rule) int {
+		return int(flex.StringToInt32Value(a.Priority) - flex.StringToInt32Value(b.Priority))
+	})
+
+	return output, nil
+}
+
+type listenerRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	ListenerARN fwtypes.ARN                                                 `tfsdk:"listener_arn"`
+	Rules       fwtypes.ListNestedObjectValueOf[listenerRuleExclusiveModel] `tfsdk:"rule"`
+}
+
+type listenerRuleExclusiveModel struct {
+	ARN      fwtypes.ARN `tfsdk:"arn"`
+	Priority types.Int32 `tfsdk:"priority"`
+}
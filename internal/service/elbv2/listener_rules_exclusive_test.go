@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2ListenerRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener_rules_exclusive.test"
+	listenerResourceName := "aws_lb_listener.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesExclusiveConfig_basic(rName, "a", 10, "b", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", listenerResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.arn", "aws_lb_listener_rule.a", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.1.arn", "aws_lb_listener_rule.b", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.priority", "20"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "listener_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "listener_arn",
+			},
+		},
+	})
+}
+
+// Swapping the priorities of two rules must not collide
+func TestAccELBV2ListenerRulesExclusive_swapPriorities(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesExclusiveConfig_basic(rName, "a", 10, "b", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.arn", "aws_lb_listener_rule.a", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.1.arn", "aws_lb_listener_rule.b", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccListenerRulesExclusiveConfig_basic(rName, "b", 10, "a", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.arn", "aws_lb_listener_rule.b", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.1.arn", "aws_lb_listener_rule.a", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.priority", "20"),
+				),
+			},
+		},
+	})
+}
+
+// A rule added out of band should be deleted
+func TestAccELBV2ListenerRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesExclusiveConfig_basic(rName, "a", 10, "b", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRulesExclusiveExists(ctx, resourceName),
+					testAccCheckListenerRulesExclusiveCreateRule(ctx, resourceName, 15),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccListenerRulesExclusiveConfig_basic(rName, "a", 10, "b", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2ListenerRulesExclusive_priorityCollision(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccListenerRulesExclusiveConfig_basic(rName, "a", 10, "b", 10),
+				ExpectError: regexache.MustCompile(`Listener Rule Priority Collision`),
+			},
+			{
+				Config:      testAccListenerRulesExclusiveConfig_basic(rName, "a", 20, "b", 10),
+				ExpectError: regexache.MustCompile(`Listener Rules Out Of Order`),
+			},
+		},
+	})
+}
+
+func testAccCheckListenerRulesExclusiveExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ELBV2, create.ErrActionCheckingExistence, tfelbv2.ResNameListenerRulesExclusive, name, errors.New("not found"))
+		}
+
+		listenerARN := rs.Primary.Attributes["listener_arn"]
+		if listenerARN == "" {
+			return create.Error(names.ELBV2, create.ErrActionCheckingExistence, tfelbv2.ResNameListenerRulesExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Client(ctx)
+		out, err := tfelbv2.FindListenerRulesExclusiveByListenerARN(ctx, conn, listenerARN)
+		if err != nil {
+			return create.Error(names.ELBV2, create.ErrActionCheckingExistence, tfelbv2.ResNameListenerRulesExclusive, listenerARN, err)
+		}
+
+		ruleCount := rs.Primary.Attributes["rule.#"]
+		if ruleCount != strconv.Itoa(len(out)) {
+			return create.Error(names.ELBV2, create.ErrActionCheckingExistence, tfelbv2.ResNameListenerRulesExclusive, listenerARN, errors.New("unexpected rule count"))
+		}
+
+		for i, rule := range out {
+			if got, want := rs.Primary.Attributes[fmt.Sprintf("rule.%d.arn", i)], aws.ToString(rule.RuleArn); got != want {
+				return create.Error(names.ELBV2, create.ErrActionCheckingExistence, tfelbv2.ResNameListenerRulesExclusive, listenerARN, fmt.Errorf("rule %d: expected %s, got %s", i, want, got))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckListenerRulesExclusiveCreateRule(ctx context.Context, name string, priority int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Client(ctx)
+		input := elasticloadbalancingv2.CreateRuleInput{
+			Actions: []awstypes.Action{{
+				FixedResponseConfig: &awstypes.FixedResponseActionConfig{
+					StatusCode: aws.String("404"),
+				},
+				Type: awstypes.ActionTypeEnumFixedResponse,
+			}},
+			Conditions: []awstypes.RuleCondition{{
+				Field:  aws.String("path-pattern"),
+				Values: []string{"/out-of-band/*"},
+			}},
+			ListenerArn: aws.String(rs.Primary.Attributes["listener_arn"]),
+			Priority:    aws.Int32(priority),
+		}
+		_, err := conn.CreateRule(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccListenerRulesExclusiveConfig_basic(rName, first string, firstPriority int, second string, secondPriority int) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_baseWithHTTPListener(rName), fmt.Sprintf(`
+resource "aws_lb_listener_rule" "a" {
+  listener_arn = aws_lb_listener.test.arn
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/a/*"]
+    }
+  }
+}
+
+resource "aws_lb_listener_rule" "b" {
+  listener_arn = aws_lb_listener.test.arn
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/b/*"]
+    }
+  }
+}
+
+resource "aws_lb_listener_rules_exclusive" "test" {
+  listener_arn = aws_lb_listener.test.arn
+
+  rule {
+    arn      = aws_lb_listener_rule.%[1]s.arn
+    priority = %[2]d
+  }
+
+  rule {
+    arn      = aws_lb_listener_rule.%[3]s.arn
+    priority = %[4]d
+  }
+}
+`, first, firstPriority, second, secondPriority))
+}
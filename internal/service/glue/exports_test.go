@@ -41,4 +41,6 @@ var (
 	FindSchemaByID               = findSchemaByID
 	FindTableByName              = findTableByName
 	FindTriggerByName            = findTriggerByName
+
+	CheckSchemaCompatibility = checkSchemaCompatibility
 )
@@ -5,13 +5,16 @@ package glue
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,6 +41,8 @@ func resourceSchema() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceSchemaCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -266,6 +271,39 @@ func resourceSchemaDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return diags
 }
 
+// resourceSchemaCustomizeDiff checks that a changed schema definition is compatible with the current definition
+// under the schema's compatibility mode, so that incompatible changes are reported at plan time
+// rather than when the new schema version fails to register.
+func resourceSchemaCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.HasChange("schema_definition") || d.HasChange("data_format") {
+		return nil
+	}
+
+	if !d.NewValueKnown("schema_definition") || !d.NewValueKnown("compatibility") {
+		return nil
+	}
+
+	o, n := d.GetChange("schema_definition")
+	compatibility := awstypes.Compatibility(d.Get("compatibility").(string))
+
+	problems, err := checkSchemaCompatibility(awstypes.DataFormat(d.Get("data_format").(string)), compatibility, o.(string), n.(string))
+
+	if err != nil {
+		tflog.Warn(ctx, "Unable to check Glue Schema compatibility", map[string]any{
+			"schema_arn": d.Id(),
+			"error":      err.Error(),
+		})
+
+		return nil
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("schema_definition is not %s compatible with the current version of Glue Schema (%s):\n  - %s", compatibility, d.Id(), strings.Join(problems, "\n  - "))
+	}
+
+	return nil
+}
+
 func findSchemaByID(ctx context.Context, conn *glue.Client, id string) (*glue.GetSchemaOutput, error) {
 	input := &glue.GetSchemaInput{
 		SchemaId: createSchemaID(id),
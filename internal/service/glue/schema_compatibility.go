@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+// schemaReadChecker returns the reasons why data written with the writer schema can't be read with the reader schema.
+// An error is returned if either schema definition can't be parsed.
+type schemaReadChecker func(reader, writer string) ([]string, error)
+
+var schemaReadCheckers = map[awstypes.DataFormat]schemaReadChecker{
+	awstypes.DataFormatAvro:     checkAvroSchemaRead,
+	awstypes.DataFormatJson:     checkJSONSchemaRead,
+	awstypes.DataFormatProtobuf: checkProtobufSchemaRead,
+}
+
+// checkSchemaCompatibility returns the reasons why a proposed schema definition isn't compatible with the previous definition
+// under the specified compatibility mode, as checked by the Glue Schema Registry when registering a new schema version.
+// Transitive modes (e.g. BACKWARD_ALL) are only checked against the previous definition.
+// An error is returned if either schema definition can't be parsed.
+func checkSchemaCompatibility(dataFormat awstypes.DataFormat, compatibility awstypes.Compatibility, previous, proposed string) ([]string, error) {
+	check, ok := schemaReadCheckers[dataFormat]
+
+	if !ok {
+		return nil, fmt.Errorf("unsupported data format (%s)", dataFormat)
+	}
+
+	var backward, forward bool
+
+	switch compatibility {
+	case awstypes.CompatibilityBackward, awstypes.CompatibilityBackwardAll:
+		backward = true
+	case awstypes.CompatibilityForward, awstypes.CompatibilityForwardAll:
+		forward = true
+	case awstypes.CompatibilityFull, awstypes.CompatibilityFullAll:
+		backward, forward = true, true
+	default:
+		return nil, nil
+	}
+
+	var problems []string
+
+	// Consumers using the proposed schema must be able to read data produced with the previous schema.
+	if backward {
+		v, err := check(proposed, previous)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, problem := range v {
+			problems = append(problems, "reading data written with the previous schema using the proposed schema: "+problem)
+		}
+	}
+
+	// Consumers using the previous schema must be able to read data produced with the proposed schema.
+	if forward {
+		v, err := check(previous, proposed)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, problem := range v {
+			problems = append(problems, "reading data written with the proposed schema using the previous schema: "+problem)
+		}
+	}
+
+	return problems, nil
+}
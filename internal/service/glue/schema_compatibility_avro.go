@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// avroSchema is a parsed Avro schema (https://avro.apache.org/docs/1.11.1/specification/).
+type avroSchema struct {
+	typ       string // Primitive type name, "record", "enum", "array", "map", "fixed" or "union".
+	name      string // Full name of named types.
+	aliases   []string
+	fields    []*avroField  // record
+	symbols   []string      // enum
+	hasDflt   bool          // enum has a default symbol
+	items     *avroSchema   // array
+	values    *avroSchema   // map
+	size      int           // fixed
+	branches  []*avroSchema // union
+	reference string        // Unresolved named type reference.
+}
+
+type avroField struct {
+	name    string
+	aliases []string
+	typ     *avroSchema
+	hasDflt bool
+}
+
+var avroPrimitiveTypes = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+// avroPromotions lists the writer types that can be promoted to each reader type.
+var avroPromotions = map[string][]string{
+	"long":   {"int"},
+	"float":  {"int", "long"},
+	"double": {"int", "long", "float"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+type avroParser struct {
+	named map[string]*avroSchema
+}
+
+func parseAvroSchema(definition string) (*avroSchema, error) {
+	var v any
+
+	if err := json.Unmarshal([]byte(definition), &v); err != nil {
+		return nil, err
+	}
+
+	p := &avroParser{named: make(map[string]*avroSchema)}
+
+	s, err := p.parse(v, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.resolve(s, make(map[*avroSchema]bool)); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (p *avroParser) parse(v any, namespace string) (*avroSchema, error) {
+	switch v := v.(type) {
+	case string:
+		if slices.Contains(avroPrimitiveTypes, v) {
+			return &avroSchema{typ: v}, nil
+		}
+
+		// Reference to a named type, resolved once all named types have been parsed.
+		return &avroSchema{reference: avroFullName(v, namespace)}, nil
+
+	case []any:
+		s := &avroSchema{typ: "union"}
+
+		for _, v := range v {
+			branch, err := p.parse(v, namespace)
+
+			if err != nil {
+				return nil, err
+			}
+
+			s.branches = append(s.branches, branch)
+		}
+
+		return s, nil
+
+	case map[string]any:
+		typ, ok := v["type"]
+
+		if !ok {
+			return nil, errors.New(`schema is missing "type"`)
+		}
+
+		t, ok := typ.(string)
+
+		if !ok || (t != "record" && t != "error" && t != "enum" && t != "array" && t != "map" && t != "fixed") {
+			// A primitive type, possibly with a logical type, or a nested type definition.
+			return p.parse(typ, namespace)
+		}
+
+		s := &avroSchema{typ: t}
+
+		switch t {
+		case "array":
+			items, err := p.parse(v["items"], namespace)
+
+			if err != nil {
+				return nil, fmt.Errorf("array items: %w", err)
+			}
+
+			s.items = items
+
+			return s, nil
+
+		case "map":
+			values, err := p.parse(v["values"], namespace)
+
+			if err != nil {
+				return nil, fmt.Errorf("map values: %w", err)
+			}
+
+			s.values = values
+
+			return s, nil
+
+		case "error":
+			s.typ = "record"
+		}
+
+		name, ok := v["name"].(string)
+
+		if !ok || name == "" {
+			return nil, fmt.Errorf(`%s is missing "name"`, t)
+		}
+
+		if ns, ok := v["namespace"].(string); ok && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+
+		s.name = avroFullName(name, namespace)
+		if i := strings.LastIndex(s.name, "."); i >= 0 {
+			namespace = s.name[:i]
+		} else {
+			namespace = ""
+		}
+
+		for _, alias := range avroStrings(v["aliases"]) {
+			s.aliases = append(s.aliases, avroFullName(alias, namespace))
+		}
+
+		if _, ok := p.named[s.name]; ok {
+			return nil, fmt.Errorf("duplicate named type (%s)", s.name)
+		}
+
+		p.named[s.name] = s
+
+		switch s.typ {
+		case "record":
+			fields, ok := v["fields"].([]any)
+
+			if !ok {
+				return nil, fmt.Errorf(`record (%s) is missing "fields"`, s.name)
+			}
+
+			for _, f := range fields {
+				f, ok := f.(map[string]any)
+
+				if !ok {
+					return nil, fmt.Errorf("record (%s) field must be an object", s.name)
+				}
+
+				field := &avroField{}
+				var err error
+
+				if field.name, ok = f["name"].(string); !ok {
+					return nil, fmt.Errorf(`record (%s) field is missing "name"`, s.name)
+				}
+
+				field.aliases = avroStrings(f["aliases"])
+				_, field.hasDflt = f["default"]
+
+				if field.typ, err = p.parse(f["type"], namespace); err != nil {
+					return nil, fmt.Errorf("record (%s) field (%s): %w", s.name, field.name, err)
+				}
+
+				s.fields = append(s.fields, field)
+			}
+
+		case "enum":
+			s.symbols = avroStrings(v["symbols"])
+			_, s.hasDflt = v["default"]
+
+		case "fixed":
+			size, ok := v["size"].(float64)
+
+			if !ok {
+				return nil, fmt.Errorf(`fixed (%s) is missing "size"`, s.name)
+			}
+
+			s.size = int(size)
+		}
+
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("invalid schema: %v", v)
+}
+
+// resolve replaces named type references with the named types.
+func (p *avroParser) resolve(s *avroSchema, seen map[*avroSchema]bool) error {
+	if seen[s] {
+		return nil
+	}
+	seen[s] = true
+
+	var children []*avroSchema
+	children = append(children, s.branches...)
+	for _, f := range s.fields {
+		children = append(children, f.typ)
+	}
+	if s.items != nil {
+		children = append(children, s.items)
+	}
+	if s.values != nil {
+		children = append(children, s.values)
+	}
+
+	for _, c := range children {
+		if c.reference != "" {
+			named, ok := p.named[c.reference]
+
+			// Unqualified references may also name a type in the null namespace.
+			if !ok {
+				named, ok = p.named[c.reference[strings.LastIndex(c.reference, ".")+1:]]
+			}
+
+			if !ok {
+				return fmt.Errorf("undefined named type (%s)", c.reference)
+			}
+
+			*c = *named
+		}
+
+		if err := p.resolve(c, seen); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+
+	return namespace + "." + name
+}
+
+func avroStrings(v any) []string {
+	var s []string
+
+	if l, ok := v.([]any); ok {
+		for _, e := range l {
+			if e, ok := e.(string); ok {
+				s = append(s, e)
+			}
+		}
+	}
+
+	return s
+}
+
+func (s *avroSchema) String() string {
+	if s.name != "" {
+		return fmt.Sprintf("%s %s", s.typ, s.name)
+	}
+
+	return s.typ
+}
+
+// matchesName returns whether the unqualified name or one of the aliases of reader schema s matches the name of writer schema other.
+func (s *avroSchema) matchesName(other *avroSchema) bool {
+	return avroShortName(s.name) == avroShortName(other.name) || slices.Contains(s.aliases, other.name)
+}
+
+func avroShortName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// checkAvroSchemaRead implements schemaReadChecker for Avro schemas using the Avro schema resolution rules.
+func checkAvroSchemaRead(reader, writer string) ([]string, error) {
+	r, err := parseAvroSchema(reader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := parseAvroSchema(writer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := &avroReadChecker{seen: make(map[[2]*avroSchema]bool)}
+	c.check(r, w, "")
+
+	return c.problems, nil
+}
+
+type avroReadChecker struct {
+	problems []string
+	seen     map[[2]*avroSchema]bool
+}
+
+func (c *avroReadChecker) problemf(path, format string, a ...any) {
+	msg := fmt.Sprintf(format, a...)
+
+	if path != "" {
+		msg = path + ": " + msg
+	}
+
+	c.problems = append(c.problems, msg)
+}
+
+// canRead returns whether data written with w can be read with r, without recording problems.
+func (c *avroReadChecker) canRead(r, w *avroSchema) bool {
+	sub := &avroReadChecker{seen: c.seen}
+	sub.check(r, w, "")
+
+	return len(sub.problems) == 0
+}
+
+func (c *avroReadChecker) check(r, w *avroSchema, path string) {
+	key := [2]*avroSchema{r, w}
+
+	if c.seen[key] {
+		return
+	}
+	c.seen[key] = true
+
+	if w.typ == "union" {
+		// Each writer branch must be readable.
+		for _, branch := range w.branches {
+			if r.typ == "union" {
+				if !slices.ContainsFunc(r.branches, func(rb *avroSchema) bool { return c.canRead(rb, branch) }) {
+					c.problemf(path, "writer union branch (%s) is not in the reader union", branch)
+				}
+			} else {
+				c.check(r, branch, path)
+			}
+		}
+
+		return
+	}
+
+	if r.typ == "union" {
+		// Use the first matching branch, as in the Avro schema resolution rules.
+		for _, branch := range r.branches {
+			if branch.typ == w.typ && (w.name == "" || branch.matchesName(w)) {
+				c.check(branch, w, path)
+				return
+			}
+		}
+
+		for _, branch := range r.branches {
+			if c.canRead(branch, w) {
+				return
+			}
+		}
+
+		c.problemf(path, "reader union does not contain writer type (%s)", w)
+
+		return
+	}
+
+	if r.typ != w.typ {
+		if slices.Contains(avroPromotions[r.typ], w.typ) {
+			return
+		}
+
+		c.problemf(path, "reader type (%s) does not match writer type (%s)", r, w)
+
+		return
+	}
+
+	switch r.typ {
+	case "record":
+		if !r.matchesName(w) {
+			c.problemf(path, "reader record name (%s) does not match writer record name (%s)", r.name, w.name)
+			return
+		}
+
+		for _, rf := range r.fields {
+			fieldPath := strings.TrimPrefix(path+"."+rf.name, ".")
+
+			i := slices.IndexFunc(w.fields, func(wf *avroField) bool {
+				return wf.name == rf.name || slices.Contains(rf.aliases, wf.name)
+			})
+
+			if i < 0 {
+				if !rf.hasDflt {
+					c.problemf(fieldPath, "reader field has no default value and is not in the writer record (%s)", w.name)
+				}
+
+				continue
+			}
+
+			c.check(rf.typ, w.fields[i].typ, fieldPath)
+		}
+
+	case "enum":
+		if !r.matchesName(w) {
+			c.problemf(path, "reader enum name (%s) does not match writer enum name (%s)", r.name, w.name)
+			return
+		}
+
+		if r.hasDflt {
+			return
+		}
+
+		for _, symbol := range w.symbols {
+			if !slices.Contains(r.symbols, symbol) {
+				c.problemf(path, "writer enum symbol (%s) is not in the reader enum (%s), which has no default", symbol, r.name)
+			}
+		}
+
+	case "array":
+		c.check(r.items, w.items, path+"[]")
+
+	case "map":
+		c.check(r.values, w.values, path+"{}")
+
+	case "fixed":
+		if !r.matchesName(w) {
+			c.problemf(path, "reader fixed name (%s) does not match writer fixed name (%s)", r.name, w.name)
+		} else if r.size != w.size {
+			c.problemf(path, "reader fixed size (%d) does not match writer fixed size (%d)", r.size, w.size)
+		}
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// jsonSchemaMaxDepth limits the depth of recursive schemas that are checked.
+	jsonSchemaMaxDepth = 32
+)
+
+var (
+	jsonSchemaTypes = []string{"array", "boolean", "integer", "null", "number", "object", "string"}
+
+	// jsonSchemaUnsupportedKeywords are keywords whose effect on the set of valid values isn't analyzed.
+	// Schemas using them are not checked, so that no incompatibility is falsely reported.
+	jsonSchemaUnsupportedKeywords = []string{"allOf", "anyOf", "contains", "dependencies", "dependentRequired", "dependentSchemas", "else", "if", "not", "oneOf", "propertyNames", "then", "unevaluatedItems", "unevaluatedProperties"}
+
+	// jsonSchemaUpperBounds and jsonSchemaLowerBounds map the numeric limit keywords to the instance type they apply to.
+	jsonSchemaUpperBounds = map[string]string{
+		"exclusiveMaximum": "number",
+		"maximum":          "number",
+		"maxItems":         "array",
+		"maxLength":        "string",
+		"maxProperties":    "object",
+	}
+	jsonSchemaLowerBounds = map[string]string{
+		"exclusiveMinimum": "number",
+		"minimum":          "number",
+		"minItems":         "array",
+		"minLength":        "string",
+		"minProperties":    "object",
+	}
+)
+
+// checkJSONSchemaRead implements schemaReadChecker for JSON Schema definitions.
+// Data can be read with the reader schema if every value that is valid under the writer schema is also valid under the reader schema.
+func checkJSONSchemaRead(reader, writer string) ([]string, error) {
+	var r, w any
+
+	if err := json.Unmarshal([]byte(reader), &r); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(writer), &w); err != nil {
+		return nil, err
+	}
+
+	c := &jsonSchemaReadChecker{readerRoot: r, writerRoot: w}
+	c.check(r, w, "", 0)
+
+	return c.problems, nil
+}
+
+type jsonSchemaReadChecker struct {
+	readerRoot, writerRoot any
+	problems               []string
+}
+
+func (c *jsonSchemaReadChecker) problemf(path, format string, a ...any) {
+	msg := fmt.Sprintf(format, a...)
+
+	if path != "" {
+		msg = path + ": " + msg
+	}
+
+	c.problems = append(c.problems, msg)
+}
+
+func (c *jsonSchemaReadChecker) check(r, w any, path string, depth int) {
+	if depth > jsonSchemaMaxDepth {
+		return
+	}
+
+	r, rok := resolveJSONSchemaRef(c.readerRoot, r)
+	w, wok := resolveJSONSchemaRef(c.writerRoot, w)
+
+	if !rok || !wok {
+		// Unresolvable (e.g. remote) references.
+		return
+	}
+
+	if v, ok := w.(bool); ok && !v {
+		return
+	}
+
+	if v, ok := r.(bool); ok {
+		if !v {
+			c.problemf(path, "values are not allowed by the reader schema")
+		}
+
+		return
+	}
+
+	rm, ok := r.(map[string]any)
+
+	if !ok {
+		return
+	}
+
+	wm, ok := w.(map[string]any)
+
+	if !ok {
+		if v, ok := w.(bool); ok && v {
+			wm = map[string]any{}
+		} else {
+			return
+		}
+	}
+
+	for _, k := range jsonSchemaUnsupportedKeywords {
+		if _, ok := rm[k]; ok {
+			return
+		}
+		if _, ok := wm[k]; ok {
+			return
+		}
+	}
+
+	// Types.
+	rTypes, wTypes := jsonSchemaTypesOf(rm), jsonSchemaTypesOf(wm)
+	wEnum := jsonSchemaEnumOf(wm)
+
+	if rTypes != nil {
+		if wTypes == nil && wEnum == nil {
+			c.problemf(path, "reader schema only allows type %s, writer schema allows any type", strings.Join(rTypes, ", "))
+		}
+
+		for _, t := range wTypes {
+			if !slices.Contains(rTypes, t) && (t != "integer" || !slices.Contains(rTypes, "number")) {
+				c.problemf(path, "writer type (%s) is not allowed by the reader schema (%s)", t, strings.Join(rTypes, ", "))
+			}
+		}
+	}
+
+	// Enumerated values.
+	if rEnum := jsonSchemaEnumOf(rm); rEnum != nil {
+		if wEnum == nil {
+			c.problemf(path, "reader schema only allows enumerated values, writer schema does not")
+		}
+
+		for _, v := range wEnum {
+			if !slices.ContainsFunc(rEnum, func(e any) bool { return reflect.DeepEqual(e, v) }) {
+				c.problemf(path, "writer value (%s) is not allowed by the reader schema", jsonSchemaValueString(v))
+			}
+		}
+	}
+
+	if wEnum != nil {
+		// Writer values are enumerated; other reader constraints are not analyzed.
+		return
+	}
+
+	if wTypes == nil {
+		wTypes = jsonSchemaTypes
+	}
+
+	hasType := func(t string) bool {
+		return slices.Contains(wTypes, t) || (t == "number" && slices.Contains(wTypes, "integer"))
+	}
+
+	// Limits.
+	for _, k := range slices.Sorted(maps.Keys(jsonSchemaUpperBounds)) {
+		if !hasType(jsonSchemaUpperBounds[k]) {
+			continue
+		}
+
+		if rv, ok := rm[k].(float64); ok {
+			if wv, ok := wm[k].(float64); !ok {
+				c.problemf(path, "reader schema sets %s (%s), writer schema does not", k, jsonSchemaValueString(rv))
+			} else if wv > rv {
+				c.problemf(path, "reader %s (%s) is less than writer %s (%s)", k, jsonSchemaValueString(rv), k, jsonSchemaValueString(wv))
+			}
+		}
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(jsonSchemaLowerBounds)) {
+		if !hasType(jsonSchemaLowerBounds[k]) {
+			continue
+		}
+
+		if rv, ok := rm[k].(float64); ok {
+			if wv, ok := wm[k].(float64); !ok {
+				c.problemf(path, "reader schema sets %s (%s), writer schema does not", k, jsonSchemaValueString(rv))
+			} else if wv < rv {
+				c.problemf(path, "reader %s (%s) is greater than writer %s (%s)", k, jsonSchemaValueString(rv), k, jsonSchemaValueString(wv))
+			}
+		}
+	}
+
+	if hasType("number") {
+		if rv, ok := rm["multipleOf"].(float64); ok && rv > 0 {
+			if wv, ok := wm["multipleOf"].(float64); !ok || math.Mod(wv, rv) != 0 {
+				c.problemf(path, "writer values are not all multiples of the reader multipleOf (%s)", jsonSchemaValueString(rv))
+			}
+		}
+	}
+
+	// Strings.
+	if hasType("string") {
+		if rv, ok := rm["pattern"].(string); ok && rv != wm["pattern"] {
+			c.problemf(path, "reader pattern (%s) does not match writer pattern", rv)
+		}
+	}
+
+	// Arrays.
+	if hasType("array") {
+		if rv, ok := rm["uniqueItems"].(bool); ok && rv && wm["uniqueItems"] != true {
+			c.problemf(path, "reader schema requires unique items, writer schema does not")
+		}
+
+		if _, ok := rm["items"].([]any); !ok {
+			if _, ok := wm["items"].([]any); !ok {
+				if ri, ok := rm["items"]; ok {
+					wi, ok := wm["items"]
+					if !ok {
+						wi = true
+					}
+
+					c.check(ri, wi, path+"[]", depth+1)
+				}
+			}
+		}
+	}
+
+	// Objects.
+	if hasType("object") {
+		wRequired := jsonSchemaStrings(wm["required"])
+
+		for _, k := range jsonSchemaStrings(rm["required"]) {
+			if !slices.Contains(wRequired, k) {
+				c.problemf(jsonSchemaPropertyPath(path, k), "property is required by the reader schema, not by the writer schema")
+			}
+		}
+
+		_, rPatterns := rm["patternProperties"]
+		_, wPatterns := wm["patternProperties"]
+
+		rProperties, _ := rm["properties"].(map[string]any)
+		wProperties, _ := wm["properties"].(map[string]any)
+		rAdditional, ok := rm["additionalProperties"]
+		if !ok {
+			rAdditional = true
+		}
+		wAdditional, wAdditionalSet := wm["additionalProperties"]
+		if !wAdditionalSet {
+			wAdditional = true
+		}
+
+		for _, k := range slices.Sorted(maps.Keys(mapsMerge(rProperties, wProperties))) {
+			rs, rok := rProperties[k]
+			ws, wok := wProperties[k]
+
+			if !rok {
+				if rPatterns {
+					continue
+				}
+				rs = rAdditional
+			}
+
+			if !wok {
+				// Undeclared properties are only checked if the writer schema sets additionalProperties.
+				if wPatterns || !wAdditionalSet {
+					continue
+				}
+				ws = wAdditional
+			}
+
+			c.check(rs, ws, jsonSchemaPropertyPath(path, k), depth+1)
+		}
+
+		if !rPatterns && !wPatterns && wAdditionalSet {
+			c.check(rAdditional, wAdditional, jsonSchemaPropertyPath(path, "*"), depth+1)
+		}
+	}
+}
+
+// resolveJSONSchemaRef returns the schema referenced by a local $ref, following chains of references.
+func resolveJSONSchemaRef(root, schema any) (any, bool) {
+	for range jsonSchemaMaxDepth {
+		m, ok := schema.(map[string]any)
+
+		if !ok {
+			return schema, true
+		}
+
+		ref, ok := m["$ref"].(string)
+
+		if !ok {
+			return schema, true
+		}
+
+		if !strings.HasPrefix(ref, "#") {
+			return nil, false
+		}
+
+		current := root
+
+		for token := range strings.SplitSeq(strings.TrimPrefix(strings.TrimPrefix(ref, "#"), "/"), "/") {
+			if token == "" {
+				continue
+			}
+
+			token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+			switch v := current.(type) {
+			case map[string]any:
+				if current, ok = v[token]; !ok {
+					return nil, false
+				}
+			case []any:
+				i, err := strconv.Atoi(token)
+
+				if err != nil || i < 0 || i >= len(v) {
+					return nil, false
+				}
+
+				current = v[i]
+			default:
+				return nil, false
+			}
+		}
+
+		schema = current
+	}
+
+	return nil, false
+}
+
+func jsonSchemaTypesOf(m map[string]any) []string {
+	switch v := m["type"].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		return jsonSchemaStrings(v)
+	}
+
+	return nil
+}
+
+func jsonSchemaEnumOf(m map[string]any) []any {
+	if v, ok := m["const"]; ok {
+		return []any{v}
+	}
+
+	if v, ok := m["enum"].([]any); ok {
+		return v
+	}
+
+	return nil
+}
+
+func jsonSchemaStrings(v any) []string {
+	var s []string
+
+	if l, ok := v.([]any); ok {
+		for _, e := range l {
+			if e, ok := e.(string); ok {
+				s = append(s, e)
+			}
+		}
+	}
+
+	return s
+}
+
+func jsonSchemaValueString(v any) string {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(b)
+}
+
+func jsonSchemaPropertyPath(path, property string) string {
+	if path == "" {
+		return property
+	}
+
+	return path + "." + property
+}
+
+func mapsMerge(m1, m2 map[string]any) map[string]any {
+	m := make(map[string]any, len(m1)+len(m2))
+	maps.Copy(m, m1)
+	maps.Copy(m, m2)
+
+	return m
+}
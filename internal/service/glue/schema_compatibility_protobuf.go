@@ -0,0 +1,733 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// protobufScalarKinds maps each scalar type to the group of types that share its wire encoding.
+// Changing the type of a field within a group doesn't break the reading of serialized data.
+var protobufScalarKinds = map[string]string{
+	"bool":     "varint",
+	"int32":    "varint",
+	"int64":    "varint",
+	"uint32":   "varint",
+	"uint64":   "varint",
+	"sint32":   "zigzag",
+	"sint64":   "zigzag",
+	"fixed32":  "fixed32",
+	"sfixed32": "fixed32",
+	"fixed64":  "fixed64",
+	"sfixed64": "fixed64",
+	"float":    "float",
+	"double":   "double",
+	"bytes":    "length",
+	"string":   "length",
+}
+
+// protobufFile is a parsed Protobuf schema definition (.proto file).
+type protobufFile struct {
+	syntax   string
+	pkg      string
+	messages map[string]*protobufMessage // Keyed by full name.
+	enums    map[string]bool             // Full names.
+	first    string                      // Full name of the first top-level message, the schema's record type.
+}
+
+type protobufMessage struct {
+	name   string
+	fields map[int]*protobufField // Keyed by field number.
+}
+
+type protobufField struct {
+	name     string
+	label    string // "optional", "required", "repeated" or "".
+	typ      string // Type as written.
+	resolved string // Full name of a message or enum type, or the scalar or map type.
+	oneof    string
+}
+
+// kind returns the field's wire encoding group, "message" or "map".
+func (f *protobufField) kind(file *protobufFile) string {
+	if kind, ok := protobufScalarKinds[f.resolved]; ok {
+		return kind
+	}
+
+	if strings.HasPrefix(f.resolved, "map<") {
+		return "map"
+	}
+
+	if file.enums[f.resolved] {
+		// Enums are encoded as varints.
+		return "varint"
+	}
+
+	return "message"
+}
+
+// relativeType returns the field's type relative to the file's package, so that fields in files with different packages can be compared.
+func (f *protobufField) relativeType(file *protobufFile) string {
+	if file.pkg != "" {
+		return strings.TrimPrefix(f.resolved, file.pkg+".")
+	}
+
+	return f.resolved
+}
+
+// checkProtobufSchemaRead implements schemaReadChecker for Protobuf schema definitions using the Protobuf wire compatibility rules.
+func checkProtobufSchemaRead(reader, writer string) ([]string, error) {
+	r, err := parseProtobufSchema(reader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := parseProtobufSchema(writer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+
+	if r.syntax != w.syntax {
+		problems = append(problems, fmt.Sprintf("reader syntax (%s) does not match writer syntax (%s)", r.syntax, w.syntax))
+	}
+
+	if r.pkg != w.pkg {
+		problems = append(problems, fmt.Sprintf("reader package (%s) does not match writer package (%s)", r.pkg, w.pkg))
+	}
+
+	relativeName := func(file *protobufFile, name string) string {
+		if file.pkg != "" {
+			return strings.TrimPrefix(name, file.pkg+".")
+		}
+		return name
+	}
+
+	rMessages := make(map[string]*protobufMessage)
+	for name, m := range r.messages {
+		rMessages[relativeName(r, name)] = m
+	}
+
+	if first := relativeName(w, w.first); first != "" {
+		if _, ok := rMessages[first]; !ok {
+			problems = append(problems, fmt.Sprintf("writer message (%s) is not in the reader schema", first))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(w.messages)) {
+		wm := w.messages[name]
+		name = relativeName(w, name)
+
+		rm, ok := rMessages[name]
+
+		if !ok {
+			continue
+		}
+
+		// Existing fields moved into the same oneof.
+		oneofs := make(map[string][]string)
+
+		for _, number := range slices.Sorted(maps.Keys(rm.fields)) {
+			rf := rm.fields[number]
+			path := name + "." + rf.name
+
+			wf, ok := wm.fields[number]
+
+			if !ok {
+				if rf.label == "required" {
+					problems = append(problems, fmt.Sprintf("%s: reader field (%d) is required and is not in the writer message", path, number))
+				}
+
+				continue
+			}
+
+			if rf.oneof != "" && wf.oneof == "" {
+				oneofs[rf.oneof] = append(oneofs[rf.oneof], rf.name)
+			}
+
+			if rk, wk := rf.kind(r), wf.kind(w); rk != wk {
+				problems = append(problems, fmt.Sprintf("%s: reader type (%s) is not compatible with writer type (%s) of field %d", path, rf.typ, wf.typ, number))
+				continue
+			} else if (rk == "message" || rk == "map") && rf.relativeType(r) != wf.relativeType(w) {
+				problems = append(problems, fmt.Sprintf("%s: reader type (%s) does not match writer type (%s) of field %d", path, rf.typ, wf.typ, number))
+				continue
+			}
+
+			if (rf.label == "repeated") != (wf.label == "repeated") {
+				// Only packed numeric fields have different encodings when repeated.
+				if kind := rf.kind(r); kind != "length" && kind != "message" && kind != "map" {
+					problems = append(problems, fmt.Sprintf("%s: reader and writer fields (%d) differ in whether they are repeated", path, number))
+				}
+			}
+
+			if rf.label == "required" && wf.label != "required" {
+				problems = append(problems, fmt.Sprintf("%s: reader field (%d) is required and is optional in the writer message", path, number))
+			}
+		}
+
+		for _, oneof := range slices.Sorted(maps.Keys(oneofs)) {
+			if fields := oneofs[oneof]; len(fields) > 1 {
+				problems = append(problems, fmt.Sprintf("%s: multiple existing fields (%s) are moved into oneof %s", name, strings.Join(fields, ", "), oneof))
+			}
+		}
+	}
+
+	return problems, nil
+}
+
+func parseProtobufSchema(definition string) (*protobufFile, error) {
+	tokens, err := tokenizeProtobuf(definition)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &protobufParser{
+		tokens: tokens,
+		file: &protobufFile{
+			syntax:   "proto2",
+			messages: make(map[string]*protobufMessage),
+			enums:    make(map[string]bool),
+		},
+		scopes: make(map[*protobufField]string),
+	}
+
+	if err := p.parseFile(); err != nil {
+		return nil, err
+	}
+
+	p.resolveTypes()
+
+	return p.file, nil
+}
+
+type protobufToken struct {
+	text string
+	line int
+	str  bool // String literal.
+}
+
+func tokenizeProtobuf(s string) ([]protobufToken, error) {
+	var tokens []protobufToken
+	line := 1
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == '\n':
+			line++
+			i++
+
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+
+		case strings.HasPrefix(s[i:], "//"):
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+
+			line += strings.Count(s[i:i+2+end], "\n")
+			i += end + 4
+
+		case c == '"' || c == '\'':
+			j := i + 1
+			var sb strings.Builder
+
+			for ; j < len(s) && s[j] != c; j++ {
+				if s[j] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated string", line)
+				}
+
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+
+				sb.WriteByte(s[j])
+			}
+
+			if j >= len(s) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+
+			tokens = append(tokens, protobufToken{text: sb.String(), line: line, str: true})
+			i = j + 1
+
+		case c == '_' || c == '.' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)):
+			j := i
+
+			for j < len(s) && (s[j] == '_' || s[j] == '.' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j])) ||
+				// Signs of exponents in float literals.
+				((s[j] == '+' || s[j] == '-') && j > i && (s[j-1] == 'e' || s[j-1] == 'E') && unicode.IsDigit(rune(s[i])))) {
+				j++
+			}
+
+			tokens = append(tokens, protobufToken{text: s[i:j], line: line})
+			i = j
+
+		case strings.ContainsRune("{}[]()<>=;,:-+/", rune(c)):
+			tokens = append(tokens, protobufToken{text: string(c), line: line})
+			i++
+
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+
+	return tokens, nil
+}
+
+type protobufParser struct {
+	tokens []protobufToken
+	pos    int
+	file   *protobufFile
+	scopes map[*protobufField]string // Full name of the message in which each field's type is resolved.
+}
+
+func (p *protobufParser) errorf(format string, a ...any) error {
+	line := 0
+
+	if p.pos < len(p.tokens) {
+		line = p.tokens[p.pos].line
+	} else if len(p.tokens) > 0 {
+		line = p.tokens[len(p.tokens)-1].line
+	}
+
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, a...))
+}
+
+func (p *protobufParser) peek() string {
+	if p.pos < len(p.tokens) && !p.tokens[p.pos].str {
+		return p.tokens[p.pos].text
+	}
+
+	return ""
+}
+
+func (p *protobufParser) next() (protobufToken, error) {
+	if p.pos >= len(p.tokens) {
+		return protobufToken{}, p.errorf("unexpected end of definition")
+	}
+
+	t := p.tokens[p.pos]
+	p.pos++
+
+	return t, nil
+}
+
+func (p *protobufParser) expect(text string) error {
+	t, err := p.next()
+
+	if err != nil {
+		return err
+	}
+
+	if t.str || t.text != text {
+		p.pos--
+		return p.errorf("expected %q, got %q", text, t.text)
+	}
+
+	return nil
+}
+
+func (p *protobufParser) ident() (string, error) {
+	t, err := p.next()
+
+	if err != nil {
+		return "", err
+	}
+
+	if t.str || !(t.text[0] == '_' || t.text[0] == '.' || unicode.IsLetter(rune(t.text[0]))) {
+		p.pos--
+		return "", p.errorf("expected identifier, got %q", t.text)
+	}
+
+	return t.text, nil
+}
+
+// skipStatement skips tokens up to and including the next semicolon or balanced block that ends the statement.
+func (p *protobufParser) skipStatement() error {
+	depth := 0
+
+	for {
+		t, err := p.next()
+
+		if err != nil {
+			return err
+		}
+
+		if t.str {
+			continue
+		}
+
+		switch t.text {
+		case "{":
+			depth++
+		case "}":
+			depth--
+
+			if depth == 0 {
+				return nil
+			}
+			if depth < 0 {
+				p.pos--
+				return p.errorf("unexpected %q", t.text)
+			}
+		case ";":
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+func (p *protobufParser) parseFile() error {
+	for p.pos < len(p.tokens) {
+		switch keyword := p.peek(); keyword {
+		case "syntax", "edition":
+			p.pos++
+
+			if err := p.expect("="); err != nil {
+				return err
+			}
+
+			t, err := p.next()
+
+			if err != nil {
+				return err
+			}
+
+			p.file.syntax = t.text
+
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+
+		case "package":
+			p.pos++
+
+			name, err := p.ident()
+
+			if err != nil {
+				return err
+			}
+
+			p.file.pkg = name
+
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+
+		case "message":
+			p.pos++
+
+			if err := p.parseMessage(p.file.pkg); err != nil {
+				return err
+			}
+
+		case "enum":
+			p.pos++
+
+			if err := p.parseEnum(p.file.pkg); err != nil {
+				return err
+			}
+
+		case "import", "option", "service", "extend":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+
+		case ";":
+			p.pos++
+
+		default:
+			return p.errorf("unexpected %q", p.tokens[p.pos].text)
+		}
+	}
+
+	return nil
+}
+
+func protobufFullName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+
+	return scope + "." + name
+}
+
+func (p *protobufParser) parseEnum(scope string) error {
+	name, err := p.ident()
+
+	if err != nil {
+		return err
+	}
+
+	p.file.enums[protobufFullName(scope, name)] = true
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	p.pos--
+
+	return p.skipStatement()
+}
+
+func (p *protobufParser) parseMessage(scope string) error {
+	name, err := p.ident()
+
+	if err != nil {
+		return err
+	}
+
+	return p.parseMessageBody(protobufFullName(scope, name))
+}
+
+func (p *protobufParser) parseMessageBody(fullName string) error {
+	if _, ok := p.file.messages[fullName]; ok {
+		return p.errorf("duplicate message (%s)", fullName)
+	}
+
+	m := &protobufMessage{name: fullName, fields: make(map[int]*protobufField)}
+	p.file.messages[fullName] = m
+
+	if p.file.first == "" {
+		p.file.first = fullName
+	}
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	return p.parseMessageItems(m, "")
+}
+
+// parseMessageItems parses the items of a message or oneof body up to and including the closing brace.
+func (p *protobufParser) parseMessageItems(m *protobufMessage, oneof string) error {
+	for {
+		switch keyword := p.peek(); keyword {
+		case "}":
+			p.pos++
+			return nil
+
+		case ";":
+			p.pos++
+
+		case "message":
+			p.pos++
+
+			if err := p.parseMessage(m.name); err != nil {
+				return err
+			}
+
+		case "enum":
+			p.pos++
+
+			if err := p.parseEnum(m.name); err != nil {
+				return err
+			}
+
+		case "oneof":
+			p.pos++
+
+			name, err := p.ident()
+
+			if err != nil {
+				return err
+			}
+
+			if err := p.expect("{"); err != nil {
+				return err
+			}
+
+			if err := p.parseMessageItems(m, name); err != nil {
+				return err
+			}
+
+		case "option", "reserved", "extensions", "extend":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+
+		case "":
+			if p.pos >= len(p.tokens) {
+				return p.errorf("unexpected end of definition")
+			}
+
+			return p.errorf("unexpected %q", p.tokens[p.pos].text)
+
+		default:
+			if err := p.parseField(m, oneof); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (p *protobufParser) parseField(m *protobufMessage, oneof string) error {
+	field := &protobufField{oneof: oneof}
+
+	switch keyword := p.peek(); keyword {
+	case "optional", "required", "repeated":
+		field.label = keyword
+		p.pos++
+	}
+
+	if p.peek() == "map" && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "<" {
+		p.pos += 2
+
+		key, err := p.ident()
+
+		if err != nil {
+			return err
+		}
+
+		if err := p.expect(","); err != nil {
+			return err
+		}
+
+		value, err := p.ident()
+
+		if err != nil {
+			return err
+		}
+
+		if err := p.expect(">"); err != nil {
+			return err
+		}
+
+		field.typ = fmt.Sprintf("map<%s, %s>", key, value)
+	} else {
+		typ, err := p.ident()
+
+		if err != nil {
+			return err
+		}
+
+		field.typ = typ
+	}
+
+	name, err := p.ident()
+
+	if err != nil {
+		return err
+	}
+
+	field.name = name
+
+	if err := p.expect("="); err != nil {
+		return err
+	}
+
+	t, err := p.next()
+
+	if err != nil {
+		return err
+	}
+
+	number, err := strconv.ParseInt(t.text, 0, 32)
+
+	if err != nil || number < 1 {
+		p.pos--
+		return p.errorf("invalid field number (%s)", t.text)
+	}
+
+	if _, ok := m.fields[int(number)]; ok {
+		p.pos--
+		return p.errorf("duplicate field number (%d) in message (%s)", number, m.name)
+	}
+
+	m.fields[int(number)] = field
+	p.scopes[field] = m.name
+
+	if field.typ == "group" {
+		// A proto2 group is a nested message type named after the field.
+		field.typ = name
+
+		return p.parseMessageBody(protobufFullName(m.name, name))
+	}
+
+	if p.peek() == "[" {
+		for {
+			t, err := p.next()
+
+			if err != nil {
+				return err
+			}
+
+			if !t.str && t.text == "]" {
+				break
+			}
+		}
+	}
+
+	return p.expect(";")
+}
+
+// resolveTypes resolves the types of fields using the Protobuf scoping rules.
+func (p *protobufParser) resolveTypes() {
+	for field, scope := range p.scopes {
+		if strings.HasPrefix(field.typ, "map<") {
+			key, value, _ := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(field.typ, "map<"), ">"), ", ")
+			field.resolved = fmt.Sprintf("map<%s, %s>", key, p.resolveType(scope, value))
+			continue
+		}
+
+		field.resolved = p.resolveType(scope, field.typ)
+	}
+}
+
+func (p *protobufParser) resolveType(scope, typ string) string {
+	if _, ok := protobufScalarKinds[typ]; ok {
+		return typ
+	}
+
+	if name, ok := strings.CutPrefix(typ, "."); ok {
+		return name
+	}
+
+	first, _, _ := strings.Cut(typ, ".")
+
+	for {
+		// The first component of the name is resolved in the innermost scope that defines it.
+		candidate := protobufFullName(scope, first)
+
+		if _, ok := p.file.messages[candidate]; ok || p.file.enums[candidate] || p.isPackage(candidate) {
+			return protobufFullName(scope, typ)
+		}
+
+		if scope == "" {
+			break
+		}
+
+		if i := strings.LastIndex(scope, "."); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+
+	// Imported type.
+	return typ
+}
+
+func (p *protobufParser) isPackage(name string) bool {
+	return p.file.pkg == name || strings.HasPrefix(p.file.pkg, name+".")
+}
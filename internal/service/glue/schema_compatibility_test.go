@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+)
+
+func TestCheckSchemaCompatibility(t *testing.T) {
+	t.Parallel()
+
+	const (
+		avroUser = `{
+  "type": "record",
+  "name": "User",
+  "namespace": "example",
+  "fields": [
+    {"name": "id", "type": "int"},
+    {"name": "name", "type": "string"}
+  ]
+}`
+		avroUserWithEmailDefault = `{
+  "type": "record",
+  "name": "User",
+  "namespace": "example",
+  "fields": [
+    {"name": "id", "type": "int"},
+    {"name": "name", "type": "string"},
+    {"name": "email", "type": ["null", "string"], "default": null}
+  ]
+}`
+		avroUserWithEmail = `{
+  "type": "record",
+  "name": "User",
+  "namespace": "example",
+  "fields": [
+    {"name": "id", "type": "int"},
+    {"name": "name", "type": "string"},
+    {"name": "email", "type": "string"}
+  ]
+}`
+		avroUserLongID = `{
+  "type": "record",
+  "name": "User",
+  "namespace": "example",
+  "fields": [
+    {"name": "id", "type": "long"},
+    {"name": "name", "type": "string"}
+  ]
+}`
+		avroRecursive = `{
+  "type": "record",
+  "name": "Node",
+  "fields": [
+    {"name": "value", "type": {"type": "enum", "name": "Color", "symbols": ["RED", "GREEN"]}},
+    {"name": "next", "type": ["null", "Node"], "default": null}
+  ]
+}`
+		avroRecursiveMoreSymbols = `{
+  "type": "record",
+  "name": "Node",
+  "fields": [
+    {"name": "value", "type": {"type": "enum", "name": "Color", "symbols": ["RED", "GREEN", "BLUE"]}},
+    {"name": "next", "type": ["null", "Node"], "default": null}
+  ]
+}`
+
+		jsonUser = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "id": {"type": "integer"},
+    "name": {"type": "string", "maxLength": 100}
+  },
+  "required": ["id"]
+}`
+		jsonUserRequiredName = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "id": {"type": "integer"},
+    "name": {"type": "string", "maxLength": 100}
+  },
+  "required": ["id", "name"]
+}`
+		jsonUserNumberID = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "id": {"type": "number"},
+    "name": {"type": "string", "maxLength": 200}
+  },
+  "required": ["id"]
+}`
+		jsonUserClosed = `{
+  "type": "object",
+  "properties": {
+    "id": {"type": "integer"},
+    "name": {"type": "string", "maxLength": 100}
+  },
+  "additionalProperties": false
+}`
+		jsonUserClosedWithEmail = `{
+  "type": "object",
+  "properties": {
+    "id": {"type": "integer"},
+    "name": {"type": "string", "maxLength": 100},
+    "email": {"type": "string"}
+  },
+  "additionalProperties": false
+}`
+
+		protobufUser = `
+syntax = "proto3";
+package example;
+
+// A user.
+message User {
+  int32 id = 1;
+  string name = 2;
+  Address address = 3;
+
+  message Address {
+    string city = 1;
+    float latitude = 2;
+  }
+}
+`
+		protobufUserCompatible = `
+syntax = "proto3";
+package example;
+
+message User {
+  int64 id = 1;
+  bytes full_name = 2;
+  User.Address address = 3;
+  repeated string tags = 4 [deprecated = true];
+  map<string, Status> labels = 5;
+  reserved 6 to 10;
+
+  message Address {
+    option deprecated = true;
+    string city = 1;
+  }
+}
+
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+}
+`
+		protobufUserIncompatible = `
+syntax = "proto3";
+package example;
+
+message User {
+  string id = 1;
+  oneof value {
+    string name = 2;
+    Address address = 3;
+  }
+
+  message Address {
+    repeated int32 city = 1;
+    repeated float latitude = 2;
+  }
+}
+`
+	)
+
+	testCases := map[string]struct {
+		dataFormat    awstypes.DataFormat
+		compatibility awstypes.Compatibility
+		previous      string
+		proposed      string
+		wantProblems  []string
+		wantErr       bool
+	}{
+		"Avro NONE": {
+			dataFormat:    awstypes.DataFormatAvro,
+			compatibility: awstypes.CompatibilityNone,
+			previous:      avroUser,
+			proposed:      avroUserWithEmail,
+		},
+		"Avro BACKWARD field with default": {
+			dataFormat:    awstypes.DataFormatAvro,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      avroUser,
+			proposed:      avroUserWithEmailDefault,
+		},
+		"Avro BACKWARD field without default": {
+			dataFormat:    awstypes.DataFormatAvro,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      avroUser,
+			proposed:      avroUserWithEmail,
+			wantProblems: []string{
+				"reading data written with the previous schema using the proposed schema: email: reader field has no default value and is not in the writer record (example.User)",
+			},
+		},
+		"Avro FORWARD field without default": {
+			dataFormat:    awstypes.DataFormatAvro,
+			compatibility: awstypes.CompatibilityForward,
+			previous:      avroUser,
+			proposed:      avroUserWithEmail,
+		},
+		"Avro FULL_ALL promotion": {
+			dataFormat:    awstypes.DataFormatAvro,
+			compatibility: awstypes.CompatibilityFullAll,
+			previous:      avroUser,
+			proposed:      avroUserLongID,
+			wantProblems: []string{
+				"reading data written with the proposed schema using the previous schema: id: reader type (int) does not match writer type (long)",
+			},
+		},
+		"Avro recursive enum BACKWARD": {
+			dataFormat:    awstypes.DataFormatAvro,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      avroRecursive,
+			proposed:      avroRecursiveMoreSymbols,
+		},
+		"Avro recursive enum FORWARD": {
+			dataFormat:    awstypes.DataFormatAvro,
+			compatibility: awstypes.CompatibilityForward,
+			previous:      avroRecursive,
+			proposed:      avroRecursiveMoreSymbols,
+			wantProblems: []string{
+				"reading data written with the proposed schema using the previous schema: value: writer enum symbol (BLUE) is not in the reader enum (Color), which has no default",
+			},
+		},
+		"Avro invalid": {
+			dataFormat:    awstypes.DataFormatAvro,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      avroUser,
+			proposed:      `{"type": "record", "name": "User", "fields": [{"name": "id", "type": "Missing"}]}`,
+			wantErr:       true,
+		},
+		"JSON BACKWARD required property added": {
+			dataFormat:    awstypes.DataFormatJson,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      jsonUser,
+			proposed:      jsonUserRequiredName,
+			wantProblems: []string{
+				"reading data written with the previous schema using the proposed schema: name: property is required by the reader schema, not by the writer schema",
+			},
+		},
+		"JSON FORWARD required property added": {
+			dataFormat:    awstypes.DataFormatJson,
+			compatibility: awstypes.CompatibilityForward,
+			previous:      jsonUser,
+			proposed:      jsonUserRequiredName,
+		},
+		"JSON BACKWARD widened": {
+			dataFormat:    awstypes.DataFormatJson,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      jsonUser,
+			proposed:      jsonUserNumberID,
+		},
+		"JSON FORWARD widened": {
+			dataFormat:    awstypes.DataFormatJson,
+			compatibility: awstypes.CompatibilityForward,
+			previous:      jsonUser,
+			proposed:      jsonUserNumberID,
+			wantProblems: []string{
+				"reading data written with the proposed schema using the previous schema: id: writer type (number) is not allowed by the reader schema (integer)",
+				"reading data written with the proposed schema using the previous schema: name: reader maxLength (100) is less than writer maxLength (200)",
+			},
+		},
+		"JSON closed content model property added": {
+			dataFormat:    awstypes.DataFormatJson,
+			compatibility: awstypes.CompatibilityFull,
+			previous:      jsonUserClosed,
+			proposed:      jsonUserClosedWithEmail,
+			wantProblems: []string{
+				"reading data written with the proposed schema using the previous schema: email: values are not allowed by the reader schema",
+			},
+		},
+		"Protobuf FULL compatible": {
+			dataFormat:    awstypes.DataFormatProtobuf,
+			compatibility: awstypes.CompatibilityFull,
+			previous:      protobufUser,
+			proposed:      protobufUserCompatible,
+		},
+		"Protobuf BACKWARD incompatible": {
+			dataFormat:    awstypes.DataFormatProtobuf,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      protobufUser,
+			proposed:      protobufUserIncompatible,
+			wantProblems: []string{
+				"reading data written with the previous schema using the proposed schema: User.id: reader type (string) is not compatible with writer type (int32) of field 1",
+				"reading data written with the previous schema using the proposed schema: User: multiple existing fields (name, address) are moved into oneof value",
+				"reading data written with the previous schema using the proposed schema: User.Address.city: reader type (int32) is not compatible with writer type (string) of field 1",
+				"reading data written with the previous schema using the proposed schema: User.Address.latitude: reader and writer fields (2) differ in whether they are repeated",
+			},
+		},
+		"Protobuf package changed": {
+			dataFormat:    awstypes.DataFormatProtobuf,
+			compatibility: awstypes.CompatibilityForward,
+			previous:      protobufUser,
+			proposed:      strings.Replace(protobufUser, "package example;", "package example.v2;", 1),
+			wantProblems: []string{
+				"reading data written with the proposed schema using the previous schema: reader package (example) does not match writer package (example.v2)",
+			},
+		},
+		"Protobuf proto2 required": {
+			dataFormat:    awstypes.DataFormatProtobuf,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      "message User {\n  optional int32 id = 1;\n}\n",
+			proposed:      "message User {\n  required int32 id = 1;\n  required string name = 2 [default = \"x\"];\n}\n",
+			wantProblems: []string{
+				"reading data written with the previous schema using the proposed schema: User.id: reader field (1) is required and is optional in the writer message",
+				"reading data written with the previous schema using the proposed schema: User.name: reader field (2) is required and is not in the writer message",
+			},
+		},
+		"Protobuf invalid": {
+			dataFormat:    awstypes.DataFormatProtobuf,
+			compatibility: awstypes.CompatibilityBackward,
+			previous:      protobufUser,
+			proposed:      "message User {\n  int32 id = 1\n}\n",
+			wantErr:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			problems, err := tfglue.CheckSchemaCompatibility(testCase.dataFormat, testCase.compatibility, testCase.previous, testCase.proposed)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if got, want := strings.Join(problems, "\n"), strings.Join(testCase.wantProblems, "\n"); got != want {
+				t.Errorf("problems:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
@@ -60,5 +60,8 @@ var (
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
 
-	RolePolicyParseID = rolePolicyParseID
+	MarshalMinifiedPolicyDocument = marshalMinifiedPolicyDocument
+	MinifyPolicyDocument          = minifyPolicyDocument
+	PolicyDocumentsEquivalent     = policyDocumentsEquivalent
+	RolePolicyParseID             = rolePolicyParseID
 )
@@ -10,18 +10,27 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+	policyDocumentManagedPolicySizeLimit = 6144
+)
+
 // @SDKDataSource("aws_iam_policy_document", name="Policy Document")
 func dataSourcePolicyDocument() *schema.Resource {
 	return &schema.Resource{
@@ -64,10 +73,42 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"lint": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				"minified_json": {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"minified_json_size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"minify": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"action_wildcards": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]+:[0-9A-Za-z?]*\*[0-9A-Za-z*?]*$`), "must be a service prefix followed by an action name containing '*'"),
+								},
+							},
+							"size_limit": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      policyDocumentManagedPolicySizeLimit,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
 				"override_json": {
 					Type:         schema.TypeString,
@@ -318,14 +359,48 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set(names.AttrJSON, jsonString)
 
-	jsonMinDoc, err := json.Marshal(mergedDoc)
-	if err != nil {
-		// should never happen if the above code is correct
-		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+	if d.Get("lint").(bool) {
+		warnings, err := verify.LintPolicy(jsonString)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: linting: %s", err)
+		}
+
+		for _, warning := range warnings {
+			diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document: %s", warning)
+		}
+	}
+
+	var jsonMinString string
+	var sizeLimit int
+	if v, ok := d.GetOk("minify"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		sizeLimit = tfMap["size_limit"].(int)
+
+		minDoc, err := minifyPolicyDocument(mergedDoc, flex.ExpandStringValueSet(tfMap["action_wildcards"].(*schema.Set)))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: minifying: %s", err)
+		}
+
+		jsonMinString, err = marshalMinifiedPolicyDocument(minDoc)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+		}
+	} else {
+		jsonMinDoc, err := json.Marshal(mergedDoc)
+		if err != nil {
+			// should never happen if the above code is correct
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+		}
+		jsonMinString = string(jsonMinDoc)
+	}
+
+	size := utf8.RuneCountInString(jsonMinString)
+	if sizeLimit > 0 && size > sizeLimit {
+		diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document: minified JSON is %d characters, which exceeds the size limit of %d characters", size, sizeLimit)
 	}
-	jsonMinString := string(jsonMinDoc)
 
 	d.Set("minified_json", jsonMinString)
+	d.Set("minified_json_size", size)
 
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
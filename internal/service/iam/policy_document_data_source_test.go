@@ -419,6 +419,46 @@ func TestAccIAMPolicyDocumentDataSource_StatementPrincipalIdentifiers_multiplePr
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_lint(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_lint,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "lint", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObjects","Resource":"arn:aws:s3:::test"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_minify(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_minify,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:Get*"],"Resource":["arn:aws:s3:::test2/*","arn:aws:s3:::test1/*"]},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws:s3:::test1"}]}`),
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json_size", "228"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -1676,3 +1716,44 @@ data "aws_iam_policy_document" "partial" {
   }
 }
 `
+
+const testAccPolicyDocumentDataSourceConfig_minify = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject", "s3:GetObjectVersion", "s3:PutObject"]
+    resources = ["arn:aws:s3:::test1/*"]
+  }
+
+  statement {
+    actions   = ["s3:GetObject", "s3:PutObject", "s3:GetObjectTagging"]
+    resources = ["arn:aws:s3:::test2/*"]
+  }
+
+  statement {
+    sid       = "List"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::test1"]
+  }
+
+  statement {
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::test1"]
+  }
+
+  minify {
+    action_wildcards = ["s3:Get*"]
+  }
+}
+`
+
+// Lint warnings don't prevent the policy document from being rendered.
+const testAccPolicyDocumentDataSourceConfig_lint = `
+data "aws_iam_policy_document" "test" {
+  lint = true
+
+  statement {
+    actions   = ["s3:GetObjects"]
+    resources = ["arn:aws:s3:::test"]
+  }
+}
+`
@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// minifyPolicyDocument returns a copy of doc rewritten to be as small as possible without changing its meaning.
+// Statements with the same effect, principals and conditions are merged when they differ only in their actions or only in their resources,
+// and values that are already matched by a wildcard in the same list are removed.
+// Merged statements lose their Sids.
+// Actions matched by one of the specified action wildcards are replaced by that wildcard. This broadens the policy, so is only done when explicitly requested.
+func minifyPolicyDocument(doc *IAMPolicyDoc, actionWildcards []string) (*IAMPolicyDoc, error) {
+	in := &IAMPolicyDoc{
+		Version: doc.Version,
+		Id:      doc.Id,
+	}
+	for _, stmt := range doc.Statements {
+		s := *stmt
+		if len(actionWildcards) > 0 {
+			s.Actions = policyListValue(applyPolicyActionWildcards(policyStringList(stmt.Actions), actionWildcards))
+		}
+		in.Statements = append(in.Statements, &s)
+	}
+
+	out := &IAMPolicyDoc{
+		Version: in.Version,
+		Id:      in.Id,
+	}
+	for _, stmt := range in.Statements {
+		out.Statements = append(out.Statements, normalizePolicyStatement(stmt))
+	}
+
+	for merged := true; merged; {
+		merged = false
+		for i := 0; i < len(out.Statements); i++ {
+			for j := i + 1; j < len(out.Statements); j++ {
+				if s := mergePolicyStatements(out.Statements[i], out.Statements[j]); s != nil {
+					out.Statements[i] = s
+					out.Statements = slices.Delete(out.Statements, j, j+1)
+					j = i
+					merged = true
+				}
+			}
+		}
+	}
+
+	// Belt and braces.
+	if !policyDocumentsEquivalent(in, out) {
+		return nil, errors.New("minified policy document is not equivalent to the original policy document")
+	}
+
+	return out, nil
+}
+
+// marshalMinifiedPolicyDocument returns the compact JSON encoding of doc.
+// Unlike json.Marshal, characters such as '<' and '&' are not escaped as they don't count against IAM's size limits.
+func marshalMinifiedPolicyDocument(doc *IAMPolicyDoc) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(doc); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// policyDocumentsEquivalent returns whether two policy documents grant and deny exactly the same permissions,
+// regardless of how the permissions are laid out in statements.
+func policyDocumentsEquivalent(a, b *IAMPolicyDoc) bool {
+	if a.Version != b.Version || a.Id != b.Id {
+		return false
+	}
+
+	return maps.Equal(policyPermissions(a), policyPermissions(b))
+}
+
+func applyPolicyActionWildcards(actions, wildcards []string) []string {
+	out := make([]string, len(actions))
+
+	for i, action := range actions {
+		out[i] = action
+		for _, wildcard := range wildcards {
+			if policyValueCovers(wildcard, action, true) {
+				out[i] = wildcard
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+func normalizePolicyStatement(stmt *IAMPolicyStatement) *IAMPolicyStatement {
+	s := *stmt
+	s.Actions = policyListValue(removeCoveredPolicyValues(policyStringList(stmt.Actions), true))
+	s.NotActions = policyListValue(removeCoveredPolicyValues(policyStringList(stmt.NotActions), true))
+	s.Resources = policyListValue(removeCoveredPolicyValues(policyStringList(stmt.Resources), false))
+	s.NotResources = policyListValue(removeCoveredPolicyValues(policyStringList(stmt.NotResources), false))
+
+	return &s
+}
+
+// mergePolicyStatements returns a single statement equivalent to the two statements, or nil if they can't be merged.
+// Statements can only be merged if they have the same effect, principals and conditions and
+// differ only in their actions or only in their resources; merging statements that differ in both would grant each statement's actions on the other's resources.
+func mergePolicyStatements(a, b *IAMPolicyStatement) *IAMPolicyStatement {
+	if a.Effect != b.Effect {
+		return nil
+	}
+	if policyPrincipalSetKey(a.Principals) != policyPrincipalSetKey(b.Principals) || policyPrincipalSetKey(a.NotPrincipals) != policyPrincipalSetKey(b.NotPrincipals) {
+		return nil
+	}
+	if policyConditionSetKey(a.Conditions) != policyConditionSetKey(b.Conditions) {
+		return nil
+	}
+
+	aActions, bActions := policyStringList(a.Actions), policyStringList(b.Actions)
+	aResources, bResources := policyStringList(a.Resources), policyStringList(b.Resources)
+	sameActions := policyValuesKey(aActions, true) == policyValuesKey(bActions, true)
+	sameNotActions := policyValuesKey(policyStringList(a.NotActions), true) == policyValuesKey(policyStringList(b.NotActions), true)
+	sameResources := policyValuesKey(aResources, false) == policyValuesKey(bResources, false)
+	sameNotResources := policyValuesKey(policyStringList(a.NotResources), false) == policyValuesKey(policyStringList(b.NotResources), false)
+
+	s := *a
+	if a.Sid != b.Sid {
+		s.Sid = ""
+	}
+
+	switch {
+	case sameActions && sameNotActions && sameResources && sameNotResources:
+	case sameNotActions && sameResources && sameNotResources && len(aActions) > 0 && len(bActions) > 0:
+		s.Actions = policyListValue(removeCoveredPolicyValues(append(slices.Clone(aActions), bActions...), true))
+	case sameActions && sameNotActions && sameNotResources && len(aResources) > 0 && len(bResources) > 0:
+		s.Resources = policyListValue(removeCoveredPolicyValues(append(slices.Clone(aResources), bResources...), false))
+	default:
+		return nil
+	}
+
+	return &s
+}
+
+// removeCoveredPolicyValues removes duplicate values and values matched by a wildcard value in the same list.
+func removeCoveredPolicyValues(values []string, foldCase bool) []string {
+	var out []string
+
+	for i, v := range values {
+		covered := false
+		for j, p := range values {
+			if i == j {
+				continue
+			}
+			if policyValueEqual(p, v, foldCase) {
+				// Keep the first of any duplicates.
+				if j < i {
+					covered = true
+					break
+				}
+				continue
+			}
+			if policyValueCovers(p, v, foldCase) {
+				covered = true
+				break
+			}
+		}
+
+		if !covered {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+func policyValueEqual(a, b string, foldCase bool) bool {
+	if foldCase {
+		return strings.EqualFold(a, b)
+	}
+
+	return a == b
+}
+
+// policyValueCovers returns whether every action or resource matched by v is also matched by the pattern p.
+// Values containing policy variables are never considered covered.
+func policyValueCovers(p, v string, foldCase bool) bool {
+	if strings.Contains(p, "${") || strings.Contains(v, "${") {
+		return false
+	}
+
+	if foldCase {
+		p, v = strings.ToLower(p), strings.ToLower(v)
+	}
+
+	if !strings.ContainsAny(v, "*?") {
+		return policyWildcardMatch(p, v)
+	}
+
+	// v is itself a pattern. Only handle the common case of p being a literal prefix followed by '*'.
+	prefix, ok := strings.CutSuffix(p, "*")
+
+	return ok && !strings.ContainsAny(prefix, "*?") && strings.HasPrefix(v, prefix)
+}
+
+// policyWildcardMatch returns whether s matches pattern, in which '*' matches any sequence of characters and '?' matches any single character.
+func policyWildcardMatch(pattern, s string) bool {
+	p, i := 0, 0
+	star, mark := -1, 0
+
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, i
+			p++
+		case star != -1:
+			p = star + 1
+			mark++
+			i = mark
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// policyPermission is a single permission granted or denied by a policy statement.
+// Actions and resources are expanded one per permission; NotAction and NotResource lists are kept whole as they can't be expanded.
+type policyPermission struct {
+	effect        string
+	principals    string
+	notPrincipals string
+	conditions    string
+	action        string
+	notActions    string
+	resource      string
+	notResources  string
+}
+
+// policyPermissions returns the permissions in doc, omitting any that are implied by a broader permission.
+func policyPermissions(doc *IAMPolicyDoc) map[policyPermission]struct{} {
+	all := make(map[policyPermission]struct{})
+
+	for _, stmt := range doc.Statements {
+		base := policyPermission{
+			effect:        stmt.Effect,
+			principals:    policyPrincipalSetKey(stmt.Principals),
+			notPrincipals: policyPrincipalSetKey(stmt.NotPrincipals),
+			conditions:    policyConditionSetKey(stmt.Conditions),
+			notActions:    policyValuesKey(removeCoveredPolicyValues(policyStringList(stmt.NotActions), true), true),
+			notResources:  policyValuesKey(removeCoveredPolicyValues(policyStringList(stmt.NotResources), false), false),
+		}
+
+		actions := policyStringList(stmt.Actions)
+		if len(actions) == 0 {
+			actions = []string{""}
+		}
+		resources := policyStringList(stmt.Resources)
+		if len(resources) == 0 {
+			resources = []string{""}
+		}
+
+		for _, action := range actions {
+			for _, resource := range resources {
+				p := base
+				p.action = strings.ToLower(action)
+				p.resource = resource
+				all[p] = struct{}{}
+			}
+		}
+	}
+
+	// Index the permissions by everything but their action, and by everything but their resource.
+	byAction := make(map[policyPermission][]string)
+	byResource := make(map[policyPermission][]string)
+	for p := range all {
+		k := p
+		k.action = ""
+		byAction[k] = append(byAction[k], p.action)
+		k = p
+		k.resource = ""
+		byResource[k] = append(byResource[k], p.resource)
+	}
+
+	out := make(map[policyPermission]struct{})
+	for p := range all {
+		k := p
+		k.action = ""
+		if p.action != "" && slices.ContainsFunc(byAction[k], func(v string) bool {
+			return v != p.action && policyValueCovers(v, p.action, true)
+		}) {
+			continue
+		}
+		k = p
+		k.resource = ""
+		if p.resource != "" && slices.ContainsFunc(byResource[k], func(v string) bool {
+			return v != p.resource && policyValueCovers(v, p.resource, false)
+		}) {
+			continue
+		}
+		out[p] = struct{}{}
+	}
+
+	return out
+}
+
+func policyPrincipalSetKey(ps IAMPolicyStatementPrincipalSet) string {
+	var entries []string
+
+	for _, p := range ps {
+		for _, id := range policyStringList(p.Identifiers) {
+			entries = append(entries, p.Type+"\x01"+id)
+		}
+	}
+
+	return policyValuesKey(entries, false)
+}
+
+func policyConditionSetKey(cs IAMPolicyStatementConditionSet) string {
+	// Multiple conditions with the same operator and key are combined into a single list of values.
+	values := make(map[string][]string)
+	for _, c := range cs {
+		k := c.Test + "\x01" + c.Variable
+		values[k] = append(values[k], policyStringList(c.Values)...)
+	}
+
+	var entries []string
+	for k, v := range values {
+		entries = append(entries, k+"\x01"+policyValuesKey(v, false))
+	}
+
+	return policyValuesKey(entries, false)
+}
+
+// policyValuesKey returns a key that is the same for any two lists containing the same set of values.
+func policyValuesKey(values []string, foldCase bool) string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		if foldCase {
+			v = strings.ToLower(v)
+		}
+		out[i] = v
+	}
+	slices.Sort(out)
+
+	return strings.Join(slices.Compact(out), "\x00")
+}
+
+func policyStringList(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				out = append(out, v)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// policyListValue returns values in the form used by the policy document model: nil, a single string or a sorted list.
+func policyListValue(values []string) any {
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		out := slices.Clone(values)
+		slices.Sort(out)
+		slices.Reverse(out)
+		return out
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestMinifyPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy          string
+		actionWildcards []string
+		expected        string
+		// Whether the statements are unchanged, so the result can also be compared with verify.PolicyStringsEquivalent.
+		sameStatements bool
+	}{
+		"whitespace only": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Read",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::test/*"
+    }
+  ]
+}`,
+			expected:       `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			sameStatements: true,
+		},
+		"merge actions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::test/*"},
+    {"Effect": "Allow", "Action": ["s3:PutObject", "s3:GetObject"], "Resource": "arn:aws:s3:::test/*"}
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:aws:s3:::test/*"}]}`,
+		},
+		"merge resources": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::test1/*"},
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::test2/*"}
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::test2/*","arn:aws:s3:::test1/*"]}]}`,
+		},
+		"no merge of actions and resources": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::test1/*"},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::test2/*"}
+  ]
+}`,
+			expected:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test1/*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::test2/*"}]}`,
+			sameStatements: true,
+		},
+		"no merge of different conditions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": "true"}}},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}
+  ]
+}`,
+			expected:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			sameStatements: true,
+		},
+		"no merge of different effects": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Effect": "Deny", "Action": "s3:PutObject", "Resource": "*"}
+  ]
+}`,
+			expected:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			sameStatements: true,
+		},
+		"covered values": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": ["s3:GetObject", "S3:Get*", "s3:List*", "s3:ListBucket"], "Resource": ["arn:aws:s3:::test", "arn:aws:s3:::test/*", "arn:aws:s3:::test/a/*", "arn:aws:s3:::test/${aws:username}"]}
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:List*","S3:Get*"],"Resource":["arn:aws:s3:::test/*","arn:aws:s3:::test/${aws:username}","arn:aws:s3:::test"]}]}`,
+		},
+		"action wildcards": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": ["ec2:DescribeInstances", "ec2:DescribeVolumes", "ec2:StartInstances"], "Resource": "*"},
+    {"Effect": "Allow", "Action": ["ec2:DescribeSubnets"], "Resource": "*"}
+  ]
+}`,
+			actionWildcards: []string{"ec2:Describe*"},
+			expected:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["ec2:StartInstances","ec2:Describe*"],"Resource":"*"}]}`,
+		},
+		"principals": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "A", "Effect": "Allow", "Principal": {"Service": "lambda.amazonaws.com"}, "Action": "sts:AssumeRole"},
+    {"Sid": "B", "Effect": "Allow", "Principal": {"Service": "lambda.amazonaws.com"}, "Action": "sts:TagSession"},
+    {"Effect": "Allow", "Principal": {"Service": "ec2.amazonaws.com"}, "Action": "sts:AssumeRole"}
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sts:TagSession","sts:AssumeRole"],"Principal":{"Service":"lambda.amazonaws.com"}},{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com"}}]}`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc tfiam.IAMPolicyDoc
+			if err := json.Unmarshal([]byte(testcase.policy), &doc); err != nil {
+				t.Fatalf("unmarshaling policy: %s", err)
+			}
+
+			minDoc, err := tfiam.MinifyPolicyDocument(&doc, testcase.actionWildcards)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := tfiam.MarshalMinifiedPolicyDocument(minDoc)
+			if err != nil {
+				t.Fatalf("marshaling policy: %s", err)
+			}
+
+			if got != testcase.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", testcase.expected, got)
+			}
+
+			if testcase.sameStatements && !verify.PolicyStringsEquivalent(testcase.policy, got) {
+				t.Errorf("expected %s to be equivalent to %s", got, testcase.policy)
+			}
+		})
+	}
+}
+
+func TestPolicyDocumentsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy1  string
+		policy2  string
+		expected bool
+	}{
+		"merged statements": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::b/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"]}]}`,
+			expected: true,
+		},
+		"covered action": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*","s3:GetObject"],"Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			expected: true,
+		},
+		"cross product": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::b/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"]}]}`,
+			expected: false,
+		},
+		"not actions": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"iam:*","Resource":"*"},{"Effect":"Deny","NotAction":"sts:*","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["iam:*","sts:*"],"Resource":"*"}]}`,
+			expected: false,
+		},
+		"different version": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:  `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			expected: false,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc1, doc2 tfiam.IAMPolicyDoc
+			if err := json.Unmarshal([]byte(testcase.policy1), &doc1); err != nil {
+				t.Fatalf("unmarshaling policy: %s", err)
+			}
+			if err := json.Unmarshal([]byte(testcase.policy2), &doc2); err != nil {
+				t.Fatalf("unmarshaling policy: %s", err)
+			}
+
+			if got := tfiam.PolicyDocumentsEquivalent(&doc1, &doc2); got != testcase.expected {
+				t.Errorf("expected %t, got %t", testcase.expected, got)
+			}
+		})
+	}
+}
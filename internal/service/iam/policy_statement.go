@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	policyDocumentVersion = "2012-10-17"
+)
+
+// ErrPolicyStatementExists is returned when adding a statement whose Sid is already used in a policy.
+var ErrPolicyStatementExists = errors.New("policy statement already exists")
+
+// rawPolicyDocument is an IAM policy document whose statements are kept verbatim,
+// so that statements not being modified round-trip unchanged.
+type rawPolicyDocument struct {
+	Version    string            `json:",omitempty"`
+	Id         string            `json:",omitempty"`
+	Statements []json.RawMessage `json:"Statement"`
+}
+
+func (d *rawPolicyDocument) UnmarshalJSON(b []byte) error {
+	var v struct {
+		Version   string          `json:",omitempty"`
+		Id        string          `json:",omitempty"`
+		Statement json.RawMessage `json:",omitempty"`
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	d.Version = v.Version
+	d.Id = v.Id
+	d.Statements = nil
+
+	// Statement can be a single statement or an array of statements.
+	switch statement := bytes.TrimSpace(v.Statement); {
+	case len(statement) == 0, bytes.Equal(statement, []byte("null")):
+	case statement[0] == '[':
+		if err := json.Unmarshal(statement, &d.Statements); err != nil {
+			return err
+		}
+	default:
+		d.Statements = []json.RawMessage{statement}
+	}
+
+	return nil
+}
+
+func (d *rawPolicyDocument) indexOf(sid string) (int, error) {
+	for i, statement := range d.Statements {
+		v, err := policyStatementSid(statement)
+		if err != nil {
+			return 0, err
+		}
+
+		if v == sid {
+			return i, nil
+		}
+	}
+
+	return -1, nil
+}
+
+func (d *rawPolicyDocument) String() (string, error) {
+	if d.Version == "" {
+		d.Version = policyDocumentVersion
+	}
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func parseRawPolicyDocument(policy string) (*rawPolicyDocument, error) {
+	doc := &rawPolicyDocument{}
+
+	if policy == "" {
+		return doc, nil
+	}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	return doc, nil
+}
+
+func policyStatementSid(statement json.RawMessage) (string, error) {
+	var v struct {
+		Sid string `json:",omitempty"`
+	}
+
+	if err := json.Unmarshal(statement, &v); err != nil {
+		return "", fmt.Errorf("parsing policy statement: %w", err)
+	}
+
+	return v.Sid, nil
+}
+
+// PolicySingleStatementSid returns the Sid of the only statement in an IAM policy document.
+// An error is returned if the policy doesn't contain exactly one statement or the statement has no Sid.
+func PolicySingleStatementSid(policy string) (string, error) {
+	doc, err := parseRawPolicyDocument(policy)
+	if err != nil {
+		return "", err
+	}
+
+	if n := len(doc.Statements); n != 1 {
+		return "", fmt.Errorf("policy must contain exactly one statement, got %d", n)
+	}
+
+	sid, err := policyStatementSid(doc.Statements[0])
+	if err != nil {
+		return "", err
+	}
+
+	if sid == "" {
+		return "", errors.New("policy statement must have a Sid")
+	}
+
+	return sid, nil
+}
+
+// PolicyFindStatementBySid returns the statement with the specified Sid as a single-statement IAM policy document.
+// A NotFoundError is returned if the policy doesn't contain a statement with that Sid.
+func PolicyFindStatementBySid(policy, sid string) (string, error) {
+	doc, err := parseRawPolicyDocument(policy)
+	if err != nil {
+		return "", err
+	}
+
+	i, err := doc.indexOf(sid)
+	if err != nil {
+		return "", err
+	}
+
+	if i < 0 {
+		return "", &retry.NotFoundError{
+			Message: fmt.Sprintf("policy statement (%s) not found", sid),
+		}
+	}
+
+	return (&rawPolicyDocument{
+		Version:    doc.Version,
+		Statements: doc.Statements[i : i+1],
+	}).String()
+}
+
+// PolicyFindStatementsBySid returns all statements with the specified Sid, each as a single-statement IAM policy document.
+func PolicyFindStatementsBySid(policy, sid string) ([]string, error) {
+	doc, err := parseRawPolicyDocument(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []string
+	for _, statement := range doc.Statements {
+		v, err := policyStatementSid(statement)
+		if err != nil {
+			return nil, err
+		}
+
+		if v != sid {
+			continue
+		}
+
+		statement, err := (&rawPolicyDocument{
+			Version:    doc.Version,
+			Statements: []json.RawMessage{statement},
+		}).String()
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+// PolicyContainsStatement returns whether a policy contains a statement equivalent to the statement
+// in a single-statement IAM policy document.
+func PolicyContainsStatement(policy, statementPolicy string) (bool, error) {
+	sid, err := PolicySingleStatementSid(statementPolicy)
+	if err != nil {
+		return false, err
+	}
+
+	statements, err := PolicyFindStatementsBySid(policy, sid)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(statements, func(v string) bool {
+		return verify.PolicyStringsEquivalent(v, statementPolicy)
+	}), nil
+}
+
+// PolicyAddStatement adds the statement in a single-statement IAM policy document to a policy.
+// ErrPolicyStatementExists is returned if the policy already contains a statement with the same Sid.
+func PolicyAddStatement(policy, statementPolicy string) (string, error) {
+	return policyPutStatement(policy, statementPolicy, false)
+}
+
+// PolicyPutStatement adds the statement in a single-statement IAM policy document to a policy,
+// replacing any existing statement with the same Sid.
+func PolicyPutStatement(policy, statementPolicy string) (string, error) {
+	return policyPutStatement(policy, statementPolicy, true)
+}
+
+func policyPutStatement(policy, statementPolicy string, replace bool) (string, error) {
+	sid, err := PolicySingleStatementSid(statementPolicy)
+	if err != nil {
+		return "", err
+	}
+
+	statementDoc, err := parseRawPolicyDocument(statementPolicy)
+	if err != nil {
+		return "", err
+	}
+
+	doc, err := parseRawPolicyDocument(policy)
+	if err != nil {
+		return "", err
+	}
+
+	if doc.Version == "" {
+		doc.Version = statementDoc.Version
+	}
+
+	i, err := doc.indexOf(sid)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case i < 0:
+		doc.Statements = append(doc.Statements, statementDoc.Statements[0])
+	case replace:
+		doc.Statements[i] = statementDoc.Statements[0]
+	default:
+		return "", fmt.Errorf("%w: Sid %q", ErrPolicyStatementExists, sid)
+	}
+
+	return doc.String()
+}
+
+// PolicyRemoveStatementBySid removes the statement with the specified Sid from a policy.
+// An empty string is returned if no statements remain.
+func PolicyRemoveStatementBySid(policy, sid string) (string, error) {
+	doc, err := parseRawPolicyDocument(policy)
+	if err != nil {
+		return "", err
+	}
+
+	i, err := doc.indexOf(sid)
+	if err != nil {
+		return "", err
+	}
+
+	if i >= 0 {
+		doc.Statements = append(doc.Statements[:i], doc.Statements[i+1:]...)
+	}
+
+	if len(doc.Statements) == 0 {
+		return "", nil
+	}
+
+	return doc.String()
+}
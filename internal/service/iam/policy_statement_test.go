@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"errors"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	testPolicyStatementA = `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"}]}`
+	testPolicyStatementB = `{"Version":"2012-10-17","Statement":{"Sid":"B","Effect":"Allow","Principal":{"Service":"events.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"}}`
+)
+
+func TestPolicySingleStatementSid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy  string
+		want    string
+		wantErr bool
+	}{
+		"statement array": {
+			policy: testPolicyStatementA,
+			want:   "A",
+		},
+		"statement object": {
+			policy: testPolicyStatementB,
+			want:   "B",
+		},
+		"no Sid": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"multiple statements": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"*","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"invalid JSON": {
+			policy:  `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfiam.PolicySingleStatementSid(testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPolicyAddStatement(t *testing.T) {
+	t.Parallel()
+
+	policy, err := tfiam.PolicyAddStatement("", testPolicyStatementA)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !verify.PolicyStringsEquivalent(policy, testPolicyStatementA) {
+		t.Errorf("got %s, want %s", policy, testPolicyStatementA)
+	}
+
+	policy, err = tfiam.PolicyAddStatement(policy, testPolicyStatementB)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"},{"Sid":"B","Effect":"Allow","Principal":{"Service":"events.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"}]}`
+	if !verify.PolicyStringsEquivalent(policy, want) {
+		t.Errorf("got %s, want %s", policy, want)
+	}
+
+	_, err = tfiam.PolicyAddStatement(policy, testPolicyStatementA)
+	if !errors.Is(err, tfiam.ErrPolicyStatementExists) {
+		t.Errorf("err = %v, want %v", err, tfiam.ErrPolicyStatementExists)
+	}
+}
+
+func TestPolicyPutStatement(t *testing.T) {
+	t.Parallel()
+
+	policy := `{"Version":"2012-10-17","Id":"example","Statement":[{"Sid":"A","Effect":"Deny","Principal":"*","Action":"*","Resource":"*"},{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`
+
+	got, err := tfiam.PolicyPutStatement(policy, testPolicyStatementA)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"Version":"2012-10-17","Id":"example","Statement":[{"Sid":"A","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"},{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`
+	if !verify.PolicyStringsEquivalent(got, want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestPolicyFindStatementBySid(t *testing.T) {
+	t.Parallel()
+
+	policy, err := tfiam.PolicyAddStatement(testPolicyStatementA, testPolicyStatementB)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := tfiam.PolicyFindStatementBySid(policy, "B")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !verify.PolicyStringsEquivalent(got, testPolicyStatementB) {
+		t.Errorf("got %s, want %s", got, testPolicyStatementB)
+	}
+
+	_, err = tfiam.PolicyFindStatementBySid(policy, "C")
+	if !tfresource.NotFound(err) {
+		t.Errorf("err = %v, want NotFound", err)
+	}
+}
+
+func TestPolicyFindStatementsBySid(t *testing.T) {
+	t.Parallel()
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"*","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"*","Resource":"*"},{"Sid":"A","Effect":"Deny","Action":"*","Resource":"*"}]}`
+
+	got, err := tfiam.PolicyFindStatementsBySid(policy, "A")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{
+		`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"*","Resource":"*"}]}`,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d statements, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !verify.PolicyStringsEquivalent(got[i], want[i]) {
+			t.Errorf("statement %d: got %s, want %s", i, got[i], want[i])
+		}
+	}
+
+	got, err = tfiam.PolicyFindStatementsBySid(policy, "C")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("got %d statements, want 0", len(got))
+	}
+}
+
+func TestPolicyContainsStatement(t *testing.T) {
+	t.Parallel()
+
+	policy, err := tfiam.PolicyAddStatement(testPolicyStatementA, testPolicyStatementB)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		statementPolicy string
+		want            bool
+	}{
+		"equivalent statement": {
+			statementPolicy: `{"Version":"2012-10-17","Statement":{"Action":["sqs:SendMessage"],"Effect":"Allow","Principal":{"Service":"events.amazonaws.com"},"Resource":"*","Sid":"B"}}`,
+			want:            true,
+		},
+		"different statement with same Sid": {
+			statementPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Deny","Principal":{"Service":"events.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+		},
+		"no statement with Sid": {
+			statementPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"C","Effect":"Allow","Principal":{"Service":"events.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfiam.PolicyContainsStatement(policy, testCase.statementPolicy)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPolicyRemoveStatementBySid(t *testing.T) {
+	t.Parallel()
+
+	policy, err := tfiam.PolicyAddStatement(testPolicyStatementA, testPolicyStatementB)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := tfiam.PolicyRemoveStatementBySid(policy, "A")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !verify.PolicyStringsEquivalent(got, testPolicyStatementB) {
+		t.Errorf("got %s, want %s", got, testPolicyStatementB)
+	}
+
+	got, err = tfiam.PolicyRemoveStatementBySid(got, "B")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != "" {
+		t.Errorf("got %s, want empty policy", got)
+	}
+}
@@ -12,20 +12,23 @@ var (
 	ResourceGrant              = resourceGrant
 	ResourceKey                = resourceKey
 	ResourceKeyPolicy          = resourceKeyPolicy
+	ResourceKeyPolicyStatement = newKeyPolicyStatementResource
 	ResourceReplicaExternalKey = resourceReplicaExternalKey
 	ResourceReplicaKey         = resourceReplicaKey
 
-	AliasARNToKeyARN          = aliasARNToKeyARN
-	AliasNamePrefix           = aliasNamePrefix
-	FindCustomKeyStoreByID    = findCustomKeyStoreByID
-	FindGrantByTwoPartKey     = findGrantByTwoPartKey
-	FindKeyByID               = findKeyByID
-	FindKeyPolicyByTwoPartKey = findKeyPolicyByTwoPartKey
-	GrantParseResourceID      = grantParseResourceID
-	KeyARNOrIDEqual           = keyARNOrIDEqual
-	PropagationTimeout        = propagationTimeout
-	PolicyNameDefault         = policyNameDefault
-	SecretRemovedMessage      = secretRemovedMessage
+	AliasARNToKeyARN                         = aliasARNToKeyARN
+	AliasNamePrefix                          = aliasNamePrefix
+	FindCustomKeyStoreByID                   = findCustomKeyStoreByID
+	FindGrantByTwoPartKey                    = findGrantByTwoPartKey
+	FindKeyByID                              = findKeyByID
+	FindKeyPolicyByTwoPartKey                = findKeyPolicyByTwoPartKey
+	FindKeyPolicyStatementByTwoPartKey       = findKeyPolicyStatementByTwoPartKey
+	GrantParseResourceID                     = grantParseResourceID
+	KeyARNOrIDEqual                          = keyARNOrIDEqual
+	KeyPolicyAllowsRootAccountAdministration = keyPolicyAllowsRootAccountAdministration
+	PropagationTimeout                       = propagationTimeout
+	PolicyNameDefault                        = policyNameDefault
+	SecretRemovedMessage                     = secretRemovedMessage
 
 	ValidNameForResource   = validNameForResource
 	ValidateKeyARN         = validateKeyARN
@@ -0,0 +1,528 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kms_key_policy_statement", name="Key Policy Statement")
+func newKeyPolicyStatementResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &keyPolicyStatementResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type keyPolicyStatementResource struct {
+	framework.ResourceWithModel[keyPolicyStatementResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *keyPolicyStatementResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"sid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *keyPolicyStatementResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Policy.IsNull() || data.Policy.IsUnknown() {
+		return
+	}
+
+	sid, err := tfiam.PolicySingleStatementSid(data.Policy.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrPolicy), "Invalid policy", err.Error())
+
+		return
+	}
+
+	if !data.SID.IsNull() && !data.SID.IsUnknown() && data.SID.ValueString() != sid {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrPolicy), "Invalid policy", fmt.Sprintf("policy statement Sid (%s) must match sid (%s)", sid, data.SID.ValueString()))
+	}
+}
+
+func (r *keyPolicyStatementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	keyID, sid := data.KeyID.ValueString(), data.SID.ValueString()
+	statement := data.Policy.ValueString()
+	err := updateKeyPolicyStatement(ctx, conn, keyID, func(policy string, retrying bool) (string, error) {
+		// A retry may find the statement added by the previous attempt.
+		if retrying {
+			if ok, err := tfiam.PolicyContainsStatement(policy, statement); err != nil || ok {
+				return policy, err
+			}
+		}
+
+		return tfiam.PolicyAddStatement(policy, statement)
+	}, r.CreateTimeout(ctx, data.Timeouts))
+
+	if errors.Is(err, tfiam.ErrPolicyStatementExists) {
+		response.Diagnostics.AddAttributeError(path.Root("sid"), fmt.Sprintf("creating KMS Key (%s) Policy Statement (%s)", keyID, sid),
+			fmt.Sprintf("The key policy already contains a statement with Sid %q. "+
+				"It may be managed by an aws_kms_key or aws_kms_key_policy resource, another aws_kms_key_policy_statement resource or outside of Terraform. "+
+				"Use a different sid or import the existing statement.", sid))
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating KMS Key (%s) Policy Statement (%s)", keyID, sid), err.Error())
+
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{keyID, sid}, keyPolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID KMS Key Policy Statement", err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *keyPolicyStatementResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	output, err := findKeyPolicyStatementByTwoPartKey(ctx, conn, data.KeyID.ValueString(), data.SID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading KMS Key Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	policy, err := verify.PolicyToSet(data.Policy.ValueString(), output)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading KMS Key Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Policy = fwtypes.IAMPolicyValue(policy)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *keyPolicyStatementResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	err := updateKeyPolicyStatement(ctx, conn, new.KeyID.ValueString(), func(policy string, _ bool) (string, error) {
+		return tfiam.PolicyPutStatement(policy, new.Policy.ValueString())
+	}, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating KMS Key Policy Statement (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *keyPolicyStatementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	sid := data.SID.ValueString()
+	err := updateKeyPolicyStatement(ctx, conn, data.KeyID.ValueString(), func(policy string, _ bool) (string, error) {
+		return tfiam.PolicyRemoveStatementBySid(policy, sid)
+	}, r.DeleteTimeout(ctx, data.Timeouts))
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if errors.Is(err, errKeyPolicyLastStatementRemoved) || errors.Is(err, errKeyPolicyRootAccountAdministrationRemoved) {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting KMS Key Policy Statement (%s)", data.ID.ValueString()),
+			err.Error()+". To stop managing the statement without removing it from the key policy, remove the resource from Terraform state instead.")
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting KMS Key Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+var (
+	errKeyPolicyConcurrentModification           = errors.New("key policy was modified concurrently")
+	errKeyPolicyLastStatementRemoved             = errors.New("refusing to remove the last statement of the key policy, as a key policy can't be empty")
+	errKeyPolicyRootAccountAdministrationRemoved = errors.New("refusing to remove the statement that allows the key's AWS account root user full access to the key, as this could make the key unmanageable")
+)
+
+// updateKeyPolicyStatement performs a read-modify-write of a key's default policy.
+// Updates to the same key are serialized within the provider. After each write the policy is re-read until
+// the change is visible. If the policy has meanwhile been changed by another writer the read-modify-write
+// is retried against the latest policy; f is then called with retrying set, as the latest policy may include the
+// previous attempt's change.
+// A change that removes the last statement of the policy, or the last statement giving the key's AWS account root user
+// full access to the key, is refused.
+func updateKeyPolicyStatement(ctx context.Context, conn *kms.Client, keyID string, f func(policy string, retrying bool) (string, error), timeout time.Duration) error {
+	key, err := findKeyByID(ctx, conn, keyID)
+
+	if err != nil {
+		return err
+	}
+
+	keyARN, err := arn.Parse(aws.ToString(key.Arn))
+
+	if err != nil {
+		return err
+	}
+
+	// The key ID may be an alias. Use the resolved key ID for locking and for the key policy APIs.
+	keyID = aws.ToString(key.KeyId)
+	conns.GlobalMutexKV.Lock(keyID)
+	defer conns.GlobalMutexKV.Unlock(keyID)
+
+	var retrying bool
+	return tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+		output, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		policy := aws.ToString(output)
+		newPolicy, err := f(policy, retrying)
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if newPolicy == "" {
+			return retry.NonRetryableError(errKeyPolicyLastStatementRemoved)
+		}
+
+		if verify.PolicyStringsEquivalent(policy, newPolicy) {
+			return nil
+		}
+
+		if err := checkKeyPolicyRootAccountAdministration(policy, newPolicy, keyARN.Partition, keyARN.AccountID); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		input := kms.PutKeyPolicyInput{
+			KeyId:      aws.String(keyID),
+			Policy:     aws.String(newPolicy),
+			PolicyName: aws.String(policyNameDefault),
+		}
+
+		_, err = tfresource.RetryWhenIsA[*awstypes.MalformedPolicyDocumentException](ctx, propagationTimeout, func() (any, error) {
+			return conn.PutKeyPolicy(ctx, &input)
+		})
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		_, err = waitKeyPolicyUpdated(ctx, conn, keyID, policy, newPolicy, timeout)
+
+		if errors.Is(err, errKeyPolicyConcurrentModification) {
+			retrying = true
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// checkKeyPolicyRootAccountAdministration returns an error if a key policy change removes the last statement
+// giving the key's AWS account root user full access to the key.
+func checkKeyPolicyRootAccountAdministration(oldPolicy, newPolicy, partition, accountID string) error {
+	old, err := keyPolicyAllowsRootAccountAdministration(oldPolicy, partition, accountID)
+
+	if err != nil {
+		return err
+	}
+
+	if !old {
+		return nil
+	}
+
+	new, err := keyPolicyAllowsRootAccountAdministration(newPolicy, partition, accountID)
+
+	if err != nil {
+		return err
+	}
+
+	if !new {
+		return errKeyPolicyRootAccountAdministrationRemoved
+	}
+
+	return nil
+}
+
+// keyPolicyAllowsRootAccountAdministration returns whether a key policy contains an unconditional statement
+// allowing the AWS account root user all KMS actions on the key, as in the default key policy.
+func keyPolicyAllowsRootAccountAdministration(policy, partition, accountID string) (bool, error) {
+	var doc tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	rootARN := arn.ARN{
+		Partition: partition,
+		Service:   "iam",
+		AccountID: accountID,
+		Resource:  "root",
+	}.String()
+
+	for _, statement := range doc.Statements {
+		if statement.Effect != "Allow" || len(statement.Conditions) > 0 || statement.NotActions != nil || statement.NotResources != nil || len(statement.NotPrincipals) > 0 {
+			continue
+		}
+
+		if actions := policyStatementValues(statement.Actions); !slices.Contains(actions, "kms:*") && !slices.Contains(actions, "*") {
+			continue
+		}
+
+		if !slices.Contains(policyStatementValues(statement.Resources), "*") {
+			continue
+		}
+
+		for _, principal := range statement.Principals {
+			if principal.Type != "AWS" {
+				continue
+			}
+
+			if identifiers := policyStatementValues(principal.Identifiers); slices.Contains(identifiers, rootARN) || slices.Contains(identifiers, accountID) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// policyStatementValues returns the values of a policy statement element that can be a string or a list of strings.
+func policyStatementValues(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		var values []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+func findKeyPolicyStatementByTwoPartKey(ctx context.Context, conn *kms.Client, keyID, sid string) (string, error) {
+	key, err := findKeyByID(ctx, conn, keyID)
+
+	if err != nil {
+		return "", err
+	}
+
+	output, err := findKeyPolicyByTwoPartKey(ctx, conn, aws.ToString(key.KeyId), policyNameDefault)
+
+	if err != nil {
+		return "", err
+	}
+
+	return tfiam.PolicyFindStatementBySid(aws.ToString(output), sid)
+}
+
+const (
+	keyPolicyStatePrevious = "previous"
+	keyPolicyStateUpdated  = "updated"
+)
+
+// statusKeyPolicy compares a key's policy with the policies before and after a write.
+// Any other policy means that the key policy has been modified concurrently.
+func statusKeyPolicy(ctx context.Context, conn *kms.Client, keyID, previous, updated string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		policy := aws.ToString(output)
+
+		switch {
+		case verify.PolicyStringsEquivalent(policy, updated):
+			return policy, keyPolicyStateUpdated, nil
+		case verify.PolicyStringsEquivalent(policy, previous):
+			return policy, keyPolicyStatePrevious, nil
+		default:
+			return nil, "", errKeyPolicyConcurrentModification
+		}
+	}
+}
+
+func waitKeyPolicyUpdated(ctx context.Context, conn *kms.Client, keyID, previous, updated string, timeout time.Duration) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{keyPolicyStatePrevious},
+		Target:                    []string{keyPolicyStateUpdated},
+		Refresh:                   statusKeyPolicy(ctx, conn, keyID, previous, updated),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 5,
+		MinTimeout:                1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
+
+type keyPolicyStatementResourceModel struct {
+	framework.WithRegionModel
+	ID       types.String      `tfsdk:"id"`
+	KeyID    types.String      `tfsdk:"key_id"`
+	Policy   fwtypes.IAMPolicy `tfsdk:"policy"`
+	SID      types.String      `tfsdk:"sid"`
+	Timeouts timeouts.Value    `tfsdk:"timeouts"`
+}
+
+const (
+	keyPolicyStatementResourceIDPartCount = 2
+)
+
+func (m *keyPolicyStatementResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), keyPolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.KeyID = types.StringValue(parts[0])
+	m.SID = types.StringValue(parts[1])
+
+	return nil
+}
@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestKeyPolicyAllowsRootAccountAdministration(t *testing.T) {
+	t.Parallel()
+
+	const (
+		partition = "aws"
+		accountID = "123456789012"
+	)
+
+	testCases := map[string]struct {
+		policy string
+		want   bool
+	}{
+		"default policy": {
+			policy: `{"Version":"2012-10-17","Id":"default","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`,
+			want:   true,
+		},
+		"account ID principal and action list": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/admin","123456789012"]},"Action":["kms:Decrypt","kms:*"],"Resource":["*"]}]}`,
+			want:   true,
+		},
+		"other account": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"kms:*","Resource":"*"}]}`,
+		},
+		"limited actions": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:Decrypt","Resource":"*"}]}`,
+		},
+		"conditional": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}}]}`,
+		},
+		"deny": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfkms.KeyPolicyAllowsRootAccountAdministration(testCase.policy, partition, accountID)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccKMSKeyPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key_policy_statement.test1"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName),
+					testAccCheckKeyPolicyStatementExists(ctx, "aws_kms_key_policy_statement.test2"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKeyID, keyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttr(resourceName, "sid", "AllowUse"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key_policy_statement.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfkms.ResourceKeyPolicyStatement, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicyStatement_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key_policy_statement.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccKeyPolicyStatementConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName),
+					testAccCheckKeyPolicyStatementExists(ctx, "aws_kms_key_policy_statement.test2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicyStatement_sidCollision(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyPolicyStatementConfig_sidCollision(rName),
+				ExpectError: regexache.MustCompile(`already contains a statement with Sid "Enable IAM User Permissions"`),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kms_key_policy_statement" {
+				continue
+			}
+
+			_, err := tfkms.FindKeyPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrKeyID], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("KMS Key Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		_, err := tfkms.FindKeyPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrKeyID], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+func testAccKeyPolicyStatementConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccKeyPolicyStatementConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccKeyPolicyStatementConfig_base(rName), `
+data "aws_iam_policy_document" "test1" {
+  statement {
+    sid       = "AllowUse"
+    actions   = ["kms:Decrypt", "kms:Encrypt"]
+    resources = ["*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = [aws_iam_role.test.arn]
+    }
+  }
+}
+
+resource "aws_kms_key_policy_statement" "test1" {
+  key_id = aws_kms_key.test.key_id
+  sid    = "AllowUse"
+  policy = data.aws_iam_policy_document.test1.json
+}
+
+data "aws_iam_policy_document" "test2" {
+  statement {
+    sid       = "AllowDescribe"
+    actions   = ["kms:DescribeKey"]
+    resources = ["*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = [aws_iam_role.test.arn]
+    }
+  }
+}
+
+resource "aws_kms_key_policy_statement" "test2" {
+  key_id = aws_kms_key.test.key_id
+  sid    = "AllowDescribe"
+  policy = data.aws_iam_policy_document.test2.json
+}
+`)
+}
+
+func testAccKeyPolicyStatementConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccKeyPolicyStatementConfig_base(rName), `
+data "aws_iam_policy_document" "test1" {
+  statement {
+    sid       = "AllowUse"
+    actions   = ["kms:Decrypt", "kms:Encrypt", "kms:GenerateDataKey*"]
+    resources = ["*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = [aws_iam_role.test.arn]
+    }
+  }
+}
+
+resource "aws_kms_key_policy_statement" "test1" {
+  key_id = aws_kms_key.test.key_id
+  sid    = "AllowUse"
+  policy = data.aws_iam_policy_document.test1.json
+}
+
+data "aws_iam_policy_document" "test2" {
+  statement {
+    sid       = "AllowDescribe"
+    actions   = ["kms:DescribeKey"]
+    resources = ["*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = [aws_iam_role.test.arn]
+    }
+  }
+}
+
+resource "aws_kms_key_policy_statement" "test2" {
+  key_id = aws_kms_key.test.key_id
+  sid    = "AllowDescribe"
+  policy = data.aws_iam_policy_document.test2.json
+}
+`)
+}
+
+func testAccKeyPolicyStatementConfig_sidCollision(rName string) string {
+	return acctest.ConfigCompose(testAccKeyPolicyStatementConfig_base(rName), `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "Enable IAM User Permissions"
+    actions   = ["kms:*"]
+    resources = ["*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+    }
+  }
+}
+
+resource "aws_kms_key_policy_statement" "test" {
+  key_id = aws_kms_key.test.key_id
+  sid    = "Enable IAM User Permissions"
+  policy = data.aws_iam_policy_document.test.json
+}
+`)
+}
@@ -18,4 +18,7 @@ var (
 	FindResourcePolicyByARN             = findResourcePolicyByARN
 	FindRuleGroupByARN                  = findRuleGroupByARN
 	FindTLSInspectionConfigurationByARN = findTLSInspectionConfigurationByARN
+
+	ParseSuricataRules        = parseSuricataRules
+	SuricataRulesOverflowLine = suricataRulesOverflowLine
 )
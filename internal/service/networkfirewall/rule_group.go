@@ -5,6 +5,8 @@ package networkfirewall
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -48,9 +50,10 @@ func resourceRuleGroup() *schema.Resource {
 					Computed: true,
 				},
 				"capacity": {
-					Type:     schema.TypeInt,
-					Required: true,
-					ForceNew: true,
+					Type:         schema.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
 				},
 				names.AttrDescription: {
 					Type:     schema.TypeString,
@@ -143,8 +146,9 @@ func resourceRuleGroup() *schema.Resource {
 											},
 										},
 										"rules_string": {
-											Type:     schema.TypeString,
-											Optional: true,
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: suricataRulesWarnings,
 										},
 										"stateful_rule": {
 											Type:     schema.TypeList,
@@ -435,8 +439,9 @@ func resourceRuleGroup() *schema.Resource {
 					},
 				},
 				"rules": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: suricataRulesWarnings,
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -458,6 +463,7 @@ func resourceRuleGroup() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
 				return forceNewIfNotRuleOrderDefault("rule_group.0.stateful_rule_options.0.rule_order", d)
 			},
+			customizeDiffRuleGroupRules,
 		),
 	}
 }
@@ -618,6 +624,104 @@ func resourceRuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta a
 	return diags
 }
 
+// customizeDiffRuleGroupRules validates Suricata compatible rules and checks that the rule group's
+// capacity is sufficient for its rules.
+func customizeDiffRuleGroupRules(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	const (
+		rulesKey       = "rules"
+		rulesStringKey = "rule_group.0.rules_source.0.rules_string"
+	)
+
+	if d.Id() != "" && !d.HasChanges("capacity", rulesKey, "rule_group") {
+		return nil
+	}
+
+	var errs []error
+	var consumed, overflowLine int
+	var overflowKey string
+	known := d.NewValueKnown("capacity")
+	capacity := d.Get("capacity").(int)
+
+	parseRules := func(key string) {
+		rules, _, err := parseSuricataRules(d.Get(key).(string))
+
+		if err != nil {
+			if d.Id() == "" || d.HasChange(key) {
+				errs = append(errs, fmt.Errorf("%s: invalid Suricata rules:\n%w", key, err))
+			}
+			known = false
+			return
+		}
+
+		if overflowKey == "" {
+			if line, ok := suricataRulesOverflowLine(rules, consumed, capacity); ok {
+				overflowKey, overflowLine = key, line
+			}
+		}
+		consumed += len(rules)
+	}
+
+	// "rules" takes precedence over "rule_group", which is Computed from "rules" when configured.
+	switch {
+	case !d.NewValueKnown(rulesKey):
+		known = false
+	case d.Get(rulesKey).(string) != "":
+		parseRules(rulesKey)
+	case !d.NewValueKnown("rule_group"):
+		known = false
+	default:
+		if v, ok := d.Get("rule_group").([]any); ok && len(v) > 0 && v[0] != nil {
+			if v := expandRuleGroup(v[0].(map[string]any)); v != nil && v.RulesSource != nil {
+				consumed += estimateRulesSourceCapacity(v.RulesSource)
+			}
+
+			switch {
+			case !d.NewValueKnown(rulesStringKey):
+				known = false
+			case d.Get(rulesStringKey).(string) != "":
+				parseRules(rulesStringKey)
+			}
+		}
+	}
+
+	if known && consumed > capacity {
+		err := fmt.Errorf("capacity (%d) is less than the estimated capacity required by the rule group's rules (%d)", capacity, consumed)
+		if overflowKey != "" {
+			err = fmt.Errorf("%w; the first rule that exceeds the capacity is on line %d of %s", err, overflowLine, overflowKey)
+		}
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// estimateRulesSourceCapacity estimates the capacity consumed by a rule group's stateful and stateless rules,
+// excluding Suricata compatible rules strings. Each stateful rule consumes one unit and each stateless rule
+// consumes the product of the number of values in each of its match settings.
+// See https://docs.aws.amazon.com/network-firewall/latest/developerguide/nwfw-rule-group-capacity.html.
+func estimateRulesSourceCapacity(apiObject *awstypes.RulesSource) int {
+	n := len(apiObject.StatefulRules)
+
+	if v := apiObject.StatelessRulesAndCustomActions; v != nil {
+		for _, rule := range v.StatelessRules {
+			n += estimateStatelessRuleCapacity(rule)
+		}
+	}
+
+	return n
+}
+
+func estimateStatelessRuleCapacity(apiObject awstypes.StatelessRule) int {
+	if apiObject.RuleDefinition == nil || apiObject.RuleDefinition.MatchAttributes == nil {
+		return 1
+	}
+
+	v := apiObject.RuleDefinition.MatchAttributes
+
+	return max(len(v.Sources), 1) * max(len(v.Destinations), 1) * max(len(v.SourcePorts), 1) * max(len(v.DestinationPorts), 1) *
+		max(len(v.Protocols), 1) * max(len(v.TCPFlags), 1)
+}
+
 func findRuleGroupByARN(ctx context.Context, conn *networkfirewall.Client, arn string) (*networkfirewall.DescribeRuleGroupOutput, error) {
 	input := &networkfirewall.DescribeRuleGroupInput{
 		RuleGroupArn: aws.String(arn),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
@@ -318,6 +319,35 @@ func TestAccNetworkFirewallRuleGroup_statefulRuleOptions(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_sourceStringValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rules := `alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:1;)
+alert tcp any any -> any 22 (msg:"SSH"; sid:2;)
+alert tcp any any -> any 3389 (msg:"RDP"; sid:3;)`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_sourceString(rName, "alert tcp any any -> any any (sid:1;)\nalert tcp any any -> any any (sid:1;)"),
+				ExpectError: regexache.MustCompile(`line 2: sid 1 is already used by the rule on line 1`),
+			},
+			{
+				Config:      testAccRuleGroupConfig_sourceString(rName, `alert tcp any any -> any any (msg:"missing sid";)`),
+				ExpectError: regexache.MustCompile(`line 1: missing required sid option`),
+			},
+			{
+				Config:      testAccRuleGroupConfig_sourceStringCapacity(rName, rules, 2),
+				ExpectError: regexache.MustCompile(`the first rule that exceeds the capacity is on line 3`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_updateStatefulRuleOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup1, ruleGroup2, ruleGroup3 networkfirewall.DescribeRuleGroupOutput
@@ -1615,6 +1645,22 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, rules)
 }
 
+func testAccRuleGroupConfig_sourceStringCapacity(rName, rules string, capacity int) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = %[3]d
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_string = %[2]q
+    }
+  }
+}
+`, rName, rules, capacity)
+}
+
 func testAccRuleGroupConfig_statefulOptions(rName, rules, ruleOrder string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
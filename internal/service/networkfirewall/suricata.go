@@ -0,0 +1,516 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Suricata compatible rules, as accepted by Network Firewall.
+// See https://docs.aws.amazon.com/network-firewall/latest/developerguide/suricata-examples.html
+// and https://docs.suricata.io/en/suricata-7.0.0/rules/intro.html.
+
+type suricataRule struct {
+	line     int
+	action   string
+	protocol string
+	options  []suricataRuleOption
+	sid      uint64
+}
+
+type suricataRuleOption struct {
+	keyword string
+	value   string
+}
+
+var (
+	suricataRuleActions = []string{
+		"alert",
+		"drop",
+		"pass",
+		"reject",
+	}
+
+	suricataRuleProtocols = []string{
+		// Packet level.
+		"icmp", "icmpv4", "icmpv6", "ip", "ip4", "ip6", "ipv4", "ipv6", "pkthdr", "sctp", "tcp", "tcp-pkt", "tcp-stream", "udp",
+		// Application layer.
+		"bittorrent-dht", "dcerpc", "dhcp", "dnp3", "dns", "enip", "ftp", "ftp-data", "http", "http1", "http2", "ike", "ikev2", "imap",
+		"krb5", "ldap", "modbus", "mqtt", "nfs", "ntp", "pgsql", "pop3", "quic", "rdp", "rfb", "sip", "smb", "smtp", "snmp", "ssh",
+		"telnet", "tftp", "tls", "websocket",
+	}
+
+	suricataRuleDirections = []string{
+		"->",
+		"<>",
+	}
+
+	// suricataRuleKeywords are the rule option keywords that aren't namespaced by an application layer protocol.
+	suricataRuleKeywords = []string{
+		// Meta.
+		"classtype", "gid", "metadata", "msg", "priority", "reference", "requires", "rev", "sid", "target",
+		// Payload.
+		"absent", "base64_data", "base64_decode", "bsize", "byte_extract", "byte_jump", "byte_math", "byte_test", "content", "depth",
+		"distance", "dsize", "endswith", "entropy", "fast_pattern", "isdataat", "nocase", "offset", "pcre", "prefilter", "rawbytes",
+		"replace", "rpc", "startswith", "within",
+		// Transformations.
+		"compress_whitespace", "dotprefix", "from_base64", "header_lowercase", "pcrexform", "strip_pseudo_headers", "strip_whitespace",
+		"to_lowercase", "to_md5", "to_sha1", "to_sha256", "to_uppercase", "url_decode", "xor",
+		// Packet headers.
+		"ack", "fragbits", "fragoffset", "geoip", "icmp_id", "icmp_seq", "icode", "id", "ip_proto", "ipopts", "itype", "sameip", "seq",
+		"tos", "ttl", "window",
+		// Flow.
+		"app-layer-event", "app-layer-protocol", "app-layer-state", "bypass", "config", "decode-event", "detection_filter",
+		"engine-event", "flags", "flow", "flowbits", "flowint", "flowvar", "hostbits", "noalert", "pktvar", "stream-event", "stream_size",
+		"tag", "threshold", "xbits",
+		// Reputation and datasets.
+		"datarep", "dataset", "iprep",
+		// Files.
+		"file_data", "filemagic", "filemd5", "filename", "filesha1", "filesha256", "filesize", "filestore", "fileext",
+		// HTTP (legacy content modifiers).
+		"http_accept", "http_accept_enc", "http_accept_lang", "http_client_body", "http_connection", "http_content_len",
+		"http_content_type", "http_cookie", "http_header", "http_header_names", "http_host", "http_location", "http_method",
+		"http_protocol", "http_raw_header", "http_raw_host", "http_raw_uri", "http_referer", "http_request_line",
+		"http_response_line", "http_server", "http_server_body", "http_start", "http_stat_code", "http_stat_msg", "http_uri",
+		"http_user_agent", "urilen",
+		// TLS (legacy keywords).
+		"ja3_hash", "ja3_string", "ja3s_hash", "ja3s_string", "ssl_state", "ssl_version", "tls_cert_expired", "tls_cert_fingerprint",
+		"tls_cert_issuer", "tls_cert_notafter", "tls_cert_notbefore", "tls_cert_serial", "tls_cert_subject", "tls_cert_valid",
+		"tls_sni",
+		// Other protocols (legacy keywords).
+		"asn1", "cip_service", "dnp3_data", "dnp3_func", "dnp3_ind", "dnp3_obj", "dns_query", "enip_command", "ftpbounce",
+		"ftpdata_command", "krb5_cname", "krb5_err_code", "krb5_msg_type", "krb5_sname", "modbus", "nfs_procedure", "ssh_proto",
+		"ssh_software",
+		// Frames and packets.
+		"frame", "pkt_data",
+	}
+
+	// suricataRuleKeywordNamespaces are the prefixes of namespaced (sticky buffer and protocol) rule option keywords.
+	suricataRuleKeywordNamespaces = []string{
+		"dcerpc", "dhcp", "dnp3", "dns", "email", "enip", "file", "flow", "ftp", "http", "http2", "icmpv4", "icmpv6", "ike", "ipv4",
+		"ipv6", "ja3", "ja3s", "ja4", "krb5", "ldap", "mqtt", "nfs", "pgsql", "pop3", "quic", "rfb", "sip", "smb", "smtp", "snmp",
+		"ssh", "tcp", "tftp", "tls", "udp", "websocket",
+	}
+
+	suricataRuleVariableRegexp = regexache.MustCompile(`^[$@][A-Za-z0-9_]+$`)
+	suricataRuleKeywordRegexp  = regexache.MustCompile(`^[a-z0-9_.\-]+$`)
+	suricataRuleContentRegexp  = regexache.MustCompile(`^!?\s*"(?s:.*)"$`)
+)
+
+// parseSuricataRules parses Suricata compatible rules, one rule per line.
+// Blank lines and lines starting with "#" are ignored and a line ending in "\" is continued on the next line.
+// Errors and warnings are reported against the line on which the rule starts.
+// Rules that can't be parsed are errors. Actions, protocols and rule option keywords that aren't known are
+// warnings, as Network Firewall may accept keywords that aren't listed here.
+func parseSuricataRules(s string) ([]suricataRule, []error, error) {
+	var rules []suricataRule
+	var errs, warnings []error
+	sids := make(map[uint64]int)
+
+	lines := strings.Split(s, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := i + 1
+		text := strings.TrimSuffix(lines[i], "\r")
+
+		for strings.HasSuffix(text, `\`) && i+1 < len(lines) {
+			i++
+			text = strings.TrimSuffix(text, `\`) + strings.TrimSuffix(lines[i], "\r")
+		}
+
+		text = strings.TrimSpace(text)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		rule, ws, err := parseSuricataRule(text)
+
+		for _, w := range ws {
+			warnings = append(warnings, fmt.Errorf("line %d: %w", line, w))
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", line, err))
+			continue
+		}
+
+		rule.line = line
+
+		if l, ok := sids[rule.sid]; ok {
+			errs = append(errs, fmt.Errorf("line %d: sid %d is already used by the rule on line %d", line, rule.sid, l))
+		} else {
+			sids[rule.sid] = line
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, warnings, err
+	}
+
+	return rules, warnings, nil
+}
+
+// parseSuricataRule parses a single Suricata rule:
+//
+//	action protocol source source_port direction destination destination_port (options)
+func parseSuricataRule(text string) (suricataRule, []error, error) {
+	var rule suricataRule
+
+	open := strings.IndexByte(text, '(')
+	if open < 0 {
+		return rule, nil, errors.New(`missing rule options, expected "("`)
+	}
+
+	if !strings.HasSuffix(text, ")") {
+		return rule, nil, errors.New(`rule options must end with ")"`)
+	}
+
+	header, err := splitSuricataRuleHeader(text[:open])
+
+	if err != nil {
+		return rule, nil, err
+	}
+
+	if n := len(header); n != 7 {
+		return rule, nil, fmt.Errorf("rule header must have 7 fields (action protocol source source_port direction destination destination_port), got %d", n)
+	}
+
+	var errs, warnings []error
+
+	rule.action, rule.protocol = header[0], strings.ToLower(header[1])
+
+	if !slices.Contains(suricataRuleActions, rule.action) {
+		warnings = append(warnings, fmt.Errorf("unknown action %q, expected one of %s", rule.action, strings.Join(suricataRuleActions, ", ")))
+	}
+
+	if !slices.Contains(suricataRuleProtocols, rule.protocol) {
+		warnings = append(warnings, fmt.Errorf("unknown protocol %q", header[1]))
+	}
+
+	if err := validateSuricataRuleAddress(header[2]); err != nil {
+		errs = append(errs, fmt.Errorf("source: %w", err))
+	}
+
+	if err := validateSuricataRulePort(header[3]); err != nil {
+		errs = append(errs, fmt.Errorf("source port: %w", err))
+	}
+
+	if !slices.Contains(suricataRuleDirections, header[4]) {
+		errs = append(errs, fmt.Errorf("invalid direction %q, expected one of %s", header[4], strings.Join(suricataRuleDirections, ", ")))
+	}
+
+	if err := validateSuricataRuleAddress(header[5]); err != nil {
+		errs = append(errs, fmt.Errorf("destination: %w", err))
+	}
+
+	if err := validateSuricataRulePort(header[6]); err != nil {
+		errs = append(errs, fmt.Errorf("destination port: %w", err))
+	}
+
+	options, optionsErr := splitSuricataRuleOptions(text[open+1 : len(text)-1])
+
+	if optionsErr != nil {
+		errs = append(errs, optionsErr)
+	}
+
+	rule.options = options
+
+	var sids, revs int
+	for _, option := range options {
+		switch option.keyword {
+		case "sid":
+			sids++
+
+			v, err := strconv.ParseUint(option.value, 10, 32)
+			if err != nil || v == 0 {
+				errs = append(errs, fmt.Errorf("sid must be a positive integer, got %q", option.value))
+				continue
+			}
+
+			rule.sid = v
+		case "rev":
+			revs++
+
+			if _, err := strconv.ParseUint(option.value, 10, 32); err != nil {
+				errs = append(errs, fmt.Errorf("rev must be a non-negative integer, got %q", option.value))
+			}
+		case "content":
+			if !suricataRuleContentRegexp.MatchString(option.value) {
+				errs = append(errs, fmt.Errorf("content must be a quoted string, got %q", option.value))
+			}
+		default:
+			if !suricataRuleKeywordRegexp.MatchString(option.keyword) {
+				errs = append(errs, fmt.Errorf("invalid rule option keyword %q", option.keyword))
+			} else if !knownSuricataRuleKeyword(option.keyword) {
+				warnings = append(warnings, fmt.Errorf("unknown rule option keyword %q", option.keyword))
+			}
+		}
+	}
+
+	switch {
+	case sids == 0 && optionsErr == nil:
+		errs = append(errs, errors.New("missing required sid option"))
+	case sids > 1:
+		errs = append(errs, errors.New("sid option must be specified only once"))
+	}
+
+	if revs > 1 {
+		errs = append(errs, errors.New("rev option must be specified only once"))
+	}
+
+	return rule, warnings, errors.Join(errs...)
+}
+
+// suricataRulesWarnings reports Suricata compatible rules with unknown actions, protocols or rule option keywords
+// as warnings. Rules that can't be parsed are reported as errors by customizeDiffRuleGroupRules.
+func suricataRulesWarnings(v any, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	s, ok := v.(string)
+	if !ok {
+		return diags
+	}
+
+	_, warnings, _ := parseSuricataRules(s)
+
+	for _, w := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Unknown Suricata rule syntax",
+			Detail:        w.Error() + ". Network Firewall may reject the rule group if it doesn't support it.",
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// splitSuricataRuleHeader splits a rule header into whitespace separated fields.
+// Address and port lists, which are enclosed in "[" and "]", may contain whitespace.
+func splitSuricataRuleHeader(s string) ([]string, error) {
+	var fields []string
+	var depth, start int
+
+	start = -1
+	for i, c := range s {
+		switch {
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth < 0 {
+				return nil, errors.New(`unbalanced "]" in rule header`)
+			}
+		case depth == 0 && (c == ' ' || c == '\t'):
+			if start >= 0 {
+				fields = append(fields, s[start:i])
+				start = -1
+			}
+			continue
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	if depth != 0 {
+		return nil, errors.New(`unbalanced "[" in rule header`)
+	}
+
+	if start >= 0 {
+		fields = append(fields, s[start:])
+	}
+
+	return fields, nil
+}
+
+// splitSuricataRuleOptions splits rule options into keywords and values.
+// Options are terminated by ";", which may be escaped with "\" or appear in a quoted string.
+func splitSuricataRuleOptions(s string) ([]suricataRuleOption, error) {
+	var options []suricataRuleOption
+	var quoted bool
+	start := 0
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ';':
+			if quoted {
+				continue
+			}
+
+			if option := strings.TrimSpace(s[start:i]); option != "" {
+				keyword, value, _ := strings.Cut(option, ":")
+				options = append(options, suricataRuleOption{
+					keyword: strings.ToLower(strings.TrimSpace(keyword)),
+					value:   strings.TrimSpace(value),
+				})
+			}
+			start = i + 1
+		}
+	}
+
+	if quoted {
+		return options, errors.New("unterminated quoted string in rule options")
+	}
+
+	if option := strings.TrimSpace(s[start:]); option != "" {
+		return options, fmt.Errorf(`rule option %q must be terminated by ";"`, option)
+	}
+
+	return options, nil
+}
+
+func knownSuricataRuleKeyword(keyword string) bool {
+	if slices.Contains(suricataRuleKeywords, keyword) {
+		return true
+	}
+
+	if namespace, _, ok := strings.Cut(keyword, "."); ok {
+		return slices.Contains(suricataRuleKeywordNamespaces, namespace)
+	}
+
+	return false
+}
+
+// validateSuricataRuleAddress validates a rule header address: "any", a variable, an IP set reference,
+// an IP address or CIDR block, or a list of addresses enclosed in "[" and "]", each optionally negated with "!".
+func validateSuricataRuleAddress(s string) error {
+	return validateSuricataRuleListOrValue(s, func(v string) error {
+		if v == "any" || suricataRuleVariableRegexp.MatchString(v) {
+			return nil
+		}
+
+		if _, err := netip.ParsePrefix(v); err == nil {
+			return nil
+		}
+
+		if _, err := netip.ParseAddr(v); err == nil {
+			return nil
+		}
+
+		// IPv4 network with a dotted decimal netmask, e.g. "10.0.0.0/255.0.0.0".
+		if ip, mask, ok := strings.Cut(v, "/"); ok {
+			if ip, err := netip.ParseAddr(ip); err == nil && ip.Is4() {
+				if mask, err := netip.ParseAddr(mask); err == nil && mask.Is4() {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("invalid address %q", v)
+	})
+}
+
+// validateSuricataRulePort validates a rule header port: "any", a variable, a port number, a port range,
+// or a list of ports enclosed in "[" and "]", each optionally negated with "!".
+func validateSuricataRulePort(s string) error {
+	return validateSuricataRuleListOrValue(s, func(v string) error {
+		if v == "any" || suricataRuleVariableRegexp.MatchString(v) {
+			return nil
+		}
+
+		parsePort := func(s string, def uint64) (uint64, error) {
+			if s == "" {
+				return def, nil
+			}
+
+			return strconv.ParseUint(s, 10, 16)
+		}
+
+		from, to, isRange := strings.Cut(v, ":")
+		if isRange && from == "" && to == "" {
+			return fmt.Errorf("invalid port %q", v)
+		}
+
+		if !isRange {
+			to = from
+		}
+
+		lo, err := parsePort(from, 0)
+		if err != nil {
+			return fmt.Errorf("invalid port %q", v)
+		}
+
+		hi, err := parsePort(to, 65535)
+		if err != nil {
+			return fmt.Errorf("invalid port %q", v)
+		}
+
+		if lo > hi {
+			return fmt.Errorf("invalid port range %q", v)
+		}
+
+		return nil
+	})
+}
+
+func validateSuricataRuleListOrValue(s string, validate func(string) error) error {
+	s = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(s), "!"))
+
+	if !strings.HasPrefix(s, "[") {
+		return validate(s)
+	}
+
+	if !strings.HasSuffix(s, "]") {
+		return fmt.Errorf("unterminated list %q", s)
+	}
+
+	var elements []string
+	var depth, start int
+	inner := s[1 : len(s)-1]
+
+	for i, c := range inner {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				elements = append(elements, inner[start:i])
+				start = i + 1
+			}
+		}
+	}
+	elements = append(elements, inner[start:])
+
+	var errs []error
+	for _, v := range elements {
+		if strings.TrimSpace(v) == "" {
+			errs = append(errs, fmt.Errorf("empty element in list %q", s))
+			continue
+		}
+
+		if err := validateSuricataRuleListOrValue(v, validate); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// suricataRulesOverflowLine returns the line of the first of rules that exceeds capacity,
+// given the capacity already consumed by preceding rules.
+func suricataRulesOverflowLine(rules []suricataRule, consumed, capacity int) (int, bool) {
+	if i := max(capacity-consumed, 0); i < len(rules) {
+		return rules[i].line, true
+	}
+
+	return 0, false
+}
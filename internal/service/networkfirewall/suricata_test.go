@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"strings"
+	"testing"
+
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+)
+
+func TestParseSuricataRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rules        string
+		wantCount    int
+		wantErrs     []string
+		wantWarnings []string
+	}{
+		"empty": {
+			rules: "",
+		},
+		"comments and blank lines": {
+			rules: "# comment\n\n   \n#alert tcp any any -> any any (sid:1;)\n",
+		},
+		"single rule": {
+			rules:     `alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:1;)`,
+			wantCount: 1,
+		},
+		"multiple rules": {
+			rules: `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"example.com"; endswith; msg:"FQDN test"; sid:1; rev:2;)
+drop tcp !10.0.0.0/8 [1024:,!2000] <> [192.168.0.1, 2001:db8::/32, @BETA] $PORTS (flow:established,to_server; sid:2;)
+reject udp any any -> any 53 (dns.query; content:"bad.example"; nocase; pcre:"/a;b\(c\)/i"; sid:3;)`,
+			wantCount: 3,
+		},
+		"line continuation": {
+			rules:     "alert tcp any any -> any 80 (msg:\"split\"; \\\n  sid:4;)",
+			wantCount: 1,
+		},
+		"escaped semicolon": {
+			rules:     `alert tcp any any -> any any (content:"a\;b"; sid:5;)`,
+			wantCount: 1,
+		},
+		"CRLF line endings": {
+			rules:     "alert tcp any any -> any any (sid:1;)\r\nalert udp any any -> any any (sid:2;)\r\n",
+			wantCount: 2,
+		},
+		"unknown action": {
+			rules:        `deny tcp any any -> any any (sid:1;)`,
+			wantCount:    1,
+			wantWarnings: []string{`line 1: unknown action "deny"`},
+		},
+		"unknown protocol": {
+			rules:        `alert foo any any -> any any (sid:1;)`,
+			wantCount:    1,
+			wantWarnings: []string{`line 1: unknown protocol "foo"`},
+		},
+		"invalid direction": {
+			rules:    `alert tcp any any <- any any (sid:1;)`,
+			wantErrs: []string{`line 1: invalid direction "<-"`},
+		},
+		"invalid address": {
+			rules:    `alert tcp 10.0.0.256 any -> [10.0.0.0/8,] any (sid:1;)`,
+			wantErrs: []string{`source: invalid address "10.0.0.256"`, `destination: empty element in list`},
+		},
+		"invalid port": {
+			rules:    `alert tcp any 70000 -> any 2000:1000 (sid:1;)`,
+			wantErrs: []string{`source port: invalid port "70000"`, `destination port: invalid port range "2000:1000"`},
+		},
+		"missing header fields": {
+			rules:    `alert tcp any any -> any (sid:1;)`,
+			wantErrs: []string{"line 1: rule header must have 7 fields"},
+		},
+		"missing options": {
+			rules:    `alert tcp any any -> any any`,
+			wantErrs: []string{`line 1: missing rule options`},
+		},
+		"unterminated options": {
+			rules:    `alert tcp any any -> any any (sid:1;`,
+			wantErrs: []string{`line 1: rule options must end with ")"`},
+		},
+		"unterminated option": {
+			rules:    `alert tcp any any -> any any (msg:"x"; sid:1)`,
+			wantErrs: []string{`line 1: rule option "sid:1" must be terminated by ";"`},
+		},
+		"unterminated string": {
+			rules:    `alert tcp any any -> any any (msg:"x; sid:1;)`,
+			wantErrs: []string{"line 1: unterminated quoted string"},
+		},
+		"unknown keyword": {
+			rules:        `alert http any any -> any any (uricontent:"/admin"; foo:bar; sid:1;)`,
+			wantCount:    1,
+			wantWarnings: []string{`line 1: unknown rule option keyword "uricontent"`, `line 1: unknown rule option keyword "foo"`},
+		},
+		"invalid keyword": {
+			rules:    `alert tcp any any -> any any (f o o:bar; sid:1;)`,
+			wantErrs: []string{`line 1: invalid rule option keyword "f o o"`},
+		},
+		"unquoted content": {
+			rules:    `alert tcp any any -> any any (content:abc; sid:1;)`,
+			wantErrs: []string{`line 1: content must be a quoted string`},
+		},
+		"missing sid": {
+			rules:    `alert tcp any any -> any any (msg:"x";)`,
+			wantErrs: []string{"line 1: missing required sid option"},
+		},
+		"invalid sid": {
+			rules:    `alert tcp any any -> any any (sid:0;)`,
+			wantErrs: []string{`line 1: sid must be a positive integer, got "0"`},
+		},
+		"invalid rev": {
+			rules:    `alert tcp any any -> any any (sid:1; rev:x;)`,
+			wantErrs: []string{`line 1: rev must be a non-negative integer, got "x"`},
+		},
+		"duplicate sid": {
+			rules:    "alert tcp any any -> any any (sid:1;)\n# comment\nalert udp any any -> any any (sid:1;)",
+			wantErrs: []string{"line 3: sid 1 is already used by the rule on line 1"},
+		},
+		"errors on multiple lines": {
+			rules:        "alert tcp any any -> any any (sid:1;)\nalert tcp any any -> any any (sid:x;)\n\nalert tcp any any -> any any (bar; sid:3;)",
+			wantErrs:     []string{"line 2: sid must be a positive integer"},
+			wantWarnings: []string{`line 4: unknown rule option keyword "bar"`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, warnings, err := tfnetworkfirewall.ParseSuricataRules(testCase.rules)
+
+			if got, want := len(warnings), len(testCase.wantWarnings); got != want {
+				t.Errorf("got %d warnings (%v), want %d", got, warnings, want)
+			}
+
+			for i, want := range testCase.wantWarnings {
+				if i < len(warnings) && !strings.Contains(warnings[i].Error(), want) {
+					t.Errorf("warning %q does not contain %q", warnings[i], want)
+				}
+			}
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got, want := len(rules), testCase.wantCount; got != want {
+					t.Errorf("got %d rules, want %d", got, want)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, want := range testCase.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSuricataRulesOverflowLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rules     string
+		consumed  int
+		capacity  int
+		wantLine  int
+		wantFound bool
+	}{
+		"within capacity": {
+			rules:    "alert tcp any any -> any any (sid:1;)\nalert tcp any any -> any any (sid:2;)",
+			capacity: 2,
+		},
+		"exceeds capacity": {
+			rules:     "alert tcp any any -> any any (sid:1;)\n# comment\nalert tcp any any -> any any (sid:2;)",
+			capacity:  1,
+			wantLine:  3,
+			wantFound: true,
+		},
+		"capacity consumed by preceding rules": {
+			rules:     "alert tcp any any -> any any (sid:1;)\nalert tcp any any -> any any (sid:2;)",
+			consumed:  5,
+			capacity:  3,
+			wantLine:  1,
+			wantFound: true,
+		},
+		"no rules after capacity consumed by preceding rules": {
+			rules:    "# comment",
+			consumed: 5,
+			capacity: 3,
+		},
+		"no rules with negative capacity": {
+			capacity: -1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, _, err := tfnetworkfirewall.ParseSuricataRules(testCase.rules)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			line, found := tfnetworkfirewall.SuricataRulesOverflowLine(rules, testCase.consumed, testCase.capacity)
+
+			if got, want := found, testCase.wantFound; got != want {
+				t.Errorf("found = %t, want %t", got, want)
+			}
+			if got, want := line, testCase.wantLine; got != want {
+				t.Errorf("line = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package rds
 
 import (
 	"context"
+	"slices"
 	"sort"
 
 	"github.com/YakDriver/go-version"
@@ -14,10 +15,13 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -165,10 +169,20 @@ func dataSourceEngineVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"version_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidVersionConstraint,
+			},
 			"version_description": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"version_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
 		},
 	}
 }
@@ -217,6 +231,8 @@ func dataSourceEngineVersionRead(ctx context.Context, d *schema.ResourceData, me
 		"preferred_upgrade_targets",
 		"preferred_versions",
 		names.AttrVersion,
+		"version_constraint",
+		"version_regex",
 	}) {
 		input.DefaultOnly = aws.Bool(true)
 	}
@@ -364,6 +380,28 @@ func dataSourceEngineVersionRead(ctx context.Context, d *schema.ResourceData, me
 		engineVersions = wMajor
 	}
 
+	if versionRegex, versionConstraint := d.Get("version_regex").(string), d.Get("version_constraint").(string); versionRegex != "" || versionConstraint != "" {
+		selector, err := semver.NewSelector(versionRegex, versionConstraint)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		engineVersions = semver.Select(selector, engineVersions, func(v awstypes.DBEngineVersion) string {
+			return aws.ToString(v.EngineVersion)
+		})
+
+		if len(engineVersions) == 0 {
+			return sdkdiag.AppendErrorf(diags, "no RDS engine versions match the criteria and version constraint: %+v", input)
+		}
+
+		// Keep only the engine versions with the highest extracted version.
+		highest, _ := selector.Version(aws.ToString(engineVersions[len(engineVersions)-1].EngineVersion))
+		engineVersions = slices.DeleteFunc(engineVersions, func(v awstypes.DBEngineVersion) bool {
+			extracted, _ := selector.Version(aws.ToString(v.EngineVersion))
+			return !extracted.Equal(highest)
+		})
+	}
+
 	var found *awstypes.DBEngineVersion
 
 	if v, ok := d.GetOk("latest"); ok && v.(bool) {
@@ -314,6 +314,42 @@ func TestAccRDSEngineVersionDataSource_latest(t *testing.T) {
 	})
 }
 
+func TestAccRDSEngineVersionDataSource_versionConstraint(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccEngineVersionPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionDataSourceConfig_versionConstraint(tfrds.InstanceEnginePostgres, "~> 16.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrVersion, regexache.MustCompile(`^16\.[0-9]+$`)),
+				),
+			},
+			{
+				Config: testAccEngineVersionDataSourceConfig_versionConstraintPreferred(`"13.9", "12.7", "11.12", "15.4", "10.17", "9.6.22"`, "< 13"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrVersion, "12.7"),
+				),
+			},
+			{
+				Config: testAccEngineVersionDataSourceConfig_versionRegex(tfrds.InstanceEngineAuroraMySQL, `mysql_aurora\.(\d+\.\d+\.\d+)$`, "~> 3.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "version_actual", regexache.MustCompile(`^8\.0\.mysql_aurora\.3\.`)),
+				),
+			},
+			{
+				Config:      testAccEngineVersionDataSourceConfig_versionConstraint(tfrds.InstanceEnginePostgres, "~> 1.0"),
+				ExpectError: regexache.MustCompile(`no RDS engine versions match the criteria and version constraint`),
+			},
+		},
+	})
+}
+
 func TestAccRDSEngineVersionDataSource_hasMinorMajor(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_rds_engine_version.test"
@@ -547,6 +583,36 @@ data "aws_rds_engine_version" "test" {
 `, engine, majorVersion)
 }
 
+func testAccEngineVersionDataSourceConfig_versionConstraint(engine, versionConstraint string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine             = %[1]q
+  version_constraint = %[2]q
+}
+`, engine, versionConstraint)
+}
+
+func testAccEngineVersionDataSourceConfig_versionConstraintPreferred(preferredVersions, versionConstraint string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine             = %[1]q
+  preferred_versions = [%[2]s]
+  version_constraint = %[3]q
+}
+`, tfrds.InstanceEngineAuroraPostgreSQL, preferredVersions, versionConstraint)
+}
+
+func testAccEngineVersionDataSourceConfig_versionRegex(engine, versionRegex, versionConstraint string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine             = %[1]q
+  latest             = true
+  version_regex      = %[2]q
+  version_constraint = %[3]q
+}
+`, engine, versionRegex, versionConstraint)
+}
+
 func testAccEngineVersionDataSourceConfig_hasMajorMinorTarget(engine string, major, minor bool) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "test" {
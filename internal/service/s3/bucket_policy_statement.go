@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_policy_statement", name="Bucket Policy Statement")
+func newBucketPolicyStatementResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketPolicyStatementResource{}
+
+	r.SetDefaultCreateTimeout(3 * time.Minute)
+	r.SetDefaultUpdateTimeout(3 * time.Minute)
+	r.SetDefaultDeleteTimeout(3 * time.Minute)
+
+	return r, nil
+}
+
+type bucketPolicyStatementResource struct {
+	framework.ResourceWithModel[bucketPolicyStatementResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *bucketPolicyStatementResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"sid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *bucketPolicyStatementResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data bucketPolicyStatementResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Policy.IsNull() || data.Policy.IsUnknown() {
+		return
+	}
+
+	sid, err := tfiam.PolicySingleStatementSid(data.Policy.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrPolicy), "Invalid policy", err.Error())
+
+		return
+	}
+
+	if !data.SID.IsNull() && !data.SID.IsUnknown() && data.SID.ValueString() != sid {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrPolicy), "Invalid policy", fmt.Sprintf("policy statement Sid (%s) must match sid (%s)", sid, data.SID.ValueString()))
+	}
+}
+
+func (r *bucketPolicyStatementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketPolicyStatementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, sid := data.Bucket.ValueString(), data.SID.ValueString()
+	conn := r.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	statement := data.Policy.ValueString()
+	err := updateBucketPolicyStatement(ctx, conn, bucket, func(policy string, retrying bool) (string, error) {
+		// A retry may find the statement added by the previous attempt.
+		if retrying {
+			if ok, err := tfiam.PolicyContainsStatement(policy, statement); err != nil || ok {
+				return policy, err
+			}
+		}
+
+		return tfiam.PolicyAddStatement(policy, statement)
+	}, r.CreateTimeout(ctx, data.Timeouts))
+
+	if errors.Is(err, tfiam.ErrPolicyStatementExists) {
+		response.Diagnostics.AddAttributeError(path.Root("sid"), fmt.Sprintf("creating S3 Bucket (%s) Policy Statement (%s)", bucket, sid),
+			fmt.Sprintf("The bucket policy already contains a statement with Sid %q. "+
+				"It may be managed by an aws_s3_bucket_policy resource, another aws_s3_bucket_policy_statement resource or outside of Terraform. "+
+				"Use a different sid or import the existing statement.", sid))
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Policy Statement (%s)", bucket, sid), err.Error())
+
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{bucket, sid}, bucketPolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID S3 Bucket Policy Statement", err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *bucketPolicyStatementResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketPolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	bucket := data.Bucket.ValueString()
+	conn := r.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	output, err := findBucketPolicyStatementByTwoPartKey(ctx, conn, bucket, data.SID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	policy, err := verify.PolicyToSet(data.Policy.ValueString(), output)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Policy = fwtypes.IAMPolicyValue(policy)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketPolicyStatementResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new bucketPolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := new.Bucket.ValueString()
+	conn := r.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	err := updateBucketPolicyStatement(ctx, conn, bucket, func(policy string, _ bool) (string, error) {
+		return tfiam.PolicyPutStatement(policy, new.Policy.ValueString())
+	}, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket Policy Statement (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *bucketPolicyStatementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bucketPolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, sid := data.Bucket.ValueString(), data.SID.ValueString()
+	conn := r.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	err := updateBucketPolicyStatement(ctx, conn, bucket, func(policy string, _ bool) (string, error) {
+		return tfiam.PolicyRemoveStatementBySid(policy, sid)
+	}, r.DeleteTimeout(ctx, data.Timeouts))
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+var errBucketPolicyConcurrentModification = errors.New("bucket policy was modified concurrently")
+
+// updateBucketPolicyStatement performs a read-modify-write of a bucket's policy.
+// Updates to the same bucket are serialized within the provider. S3 has no conditional writes for bucket policies,
+// so after each write the policy is re-read until the change is visible. If the policy has meanwhile been changed
+// by another writer the read-modify-write is retried against the latest policy; f is then called with retrying set,
+// as the latest policy may include the previous attempt's change.
+func updateBucketPolicyStatement(ctx context.Context, conn *s3.Client, bucket string, f func(policy string, retrying bool) (string, error), timeout time.Duration) error {
+	conns.GlobalMutexKV.Lock(bucket)
+	defer conns.GlobalMutexKV.Unlock(bucket)
+
+	var retrying bool
+	return tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+		policy, err := findBucketPolicyOrEmpty(ctx, conn, bucket)
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		newPolicy, err := f(policy, retrying)
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if newPolicy == "" {
+			if policy == "" {
+				return nil
+			}
+
+			input := &s3.DeleteBucketPolicyInput{
+				Bucket: aws.String(bucket),
+			}
+
+			_, err = conn.DeleteBucketPolicy(ctx, input)
+		} else {
+			if verify.PolicyStringsEquivalent(policy, newPolicy) {
+				return nil
+			}
+
+			input := &s3.PutBucketPolicyInput{
+				Bucket: aws.String(bucket),
+				Policy: aws.String(newPolicy),
+			}
+
+			_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (any, error) {
+				return conn.PutBucketPolicy(ctx, input)
+			}, errCodeMalformedPolicy)
+		}
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return retry.NonRetryableError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		_, err = waitBucketPolicyUpdated(ctx, conn, bucket, policy, newPolicy, timeout)
+
+		if errors.Is(err, errBucketPolicyConcurrentModification) {
+			retrying = true
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// findBucketPolicyOrEmpty returns a bucket's policy, or an empty string if the bucket has no policy.
+func findBucketPolicyOrEmpty(ctx context.Context, conn *s3.Client, bucket string) (string, error) {
+	output, err := findBucketPolicy(ctx, conn, bucket)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucketPolicy) || errors.Is(err, tfresource.ErrEmptyResult) {
+		return "", nil
+	}
+
+	return output, err
+}
+
+func findBucketPolicyStatementByTwoPartKey(ctx context.Context, conn *s3.Client, bucket, sid string) (string, error) {
+	output, err := findBucketPolicy(ctx, conn, bucket)
+
+	if err != nil {
+		return "", err
+	}
+
+	statements, err := tfiam.PolicyFindStatementsBySid(output, sid)
+
+	if err != nil {
+		return "", err
+	}
+
+	switch len(statements) {
+	case 0:
+		return "", &retry.NotFoundError{
+			Message: fmt.Sprintf("policy statement (%s) not found", sid),
+		}
+	case 1:
+		return statements[0], nil
+	default:
+		// Such a policy can't be managed statement by statement.
+		return "", fmt.Errorf("bucket policy contains multiple statements with Sid %q", sid)
+	}
+}
+
+const (
+	bucketPolicyStatePrevious = "previous"
+	bucketPolicyStateUpdated  = "updated"
+)
+
+// statusBucketPolicy compares a bucket's policy with the policies before and after a write.
+// Any other policy means that the bucket policy has been modified concurrently.
+func statusBucketPolicy(ctx context.Context, conn *s3.Client, bucket, previous, updated string) retry.StateRefreshFunc {
+	equivalent := func(a, b string) bool {
+		if a == "" || b == "" {
+			return a == b
+		}
+
+		return verify.PolicyStringsEquivalent(a, b)
+	}
+
+	return func() (any, string, error) {
+		output, err := findBucketPolicyOrEmpty(ctx, conn, bucket)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch {
+		case equivalent(output, updated):
+			return output, bucketPolicyStateUpdated, nil
+		case equivalent(output, previous):
+			return output, bucketPolicyStatePrevious, nil
+		default:
+			return nil, "", errBucketPolicyConcurrentModification
+		}
+	}
+}
+
+func waitBucketPolicyUpdated(ctx context.Context, conn *s3.Client, bucket, previous, updated string, timeout time.Duration) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{bucketPolicyStatePrevious},
+		Target:                    []string{bucketPolicyStateUpdated},
+		Refresh:                   statusBucketPolicy(ctx, conn, bucket, previous, updated),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 3,
+		MinTimeout:                2 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
+
+type bucketPolicyStatementResourceModel struct {
+	framework.WithRegionModel
+	Bucket   types.String      `tfsdk:"bucket"`
+	ID       types.String      `tfsdk:"id"`
+	Policy   fwtypes.IAMPolicy `tfsdk:"policy"`
+	SID      types.String      `tfsdk:"sid"`
+	Timeouts timeouts.Value    `tfsdk:"timeouts"`
+}
+
+const (
+	bucketPolicyStatementResourceIDPartCount = 2
+)
+
+func (m *bucketPolicyStatementResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), bucketPolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.Bucket = types.StringValue(parts[0])
+	m.SID = types.StringValue(parts[1])
+
+	return nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName),
+					testAccCheckBucketPolicyStatementExists(ctx, "aws_s3_bucket_policy_statement.test2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "sid", "AllowLogging"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketPolicyStatement, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccBucketPolicyStatementConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName),
+					testAccCheckBucketPolicyStatementExists(ctx, "aws_s3_bucket_policy_statement.test2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_sidCollision(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketPolicyStatementConfig_sidCollision(rName),
+				ExpectError: regexache.MustCompile(`already contains a statement with Sid "AllowLogging"`),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_policy_statement" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+			if tfs3.IsDirectoryBucket(rs.Primary.Attributes[names.AttrBucket]) {
+				conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+			}
+
+			_, err := tfs3.FindBucketPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(rs.Primary.Attributes[names.AttrBucket]) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		_, err := tfs3.FindBucketPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+func testAccBucketPolicyStatementConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_iam_policy_document" "logging" {
+  statement {
+    sid       = "AllowLogging"
+    actions   = ["s3:PutObject"]
+    resources = ["${aws_s3_bucket.test.arn}/logs/*"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["logging.s3.${data.aws_partition.current.dns_suffix}"]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceAccount"
+      values   = [data.aws_caller_identity.current.account_id]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccBucketPolicyStatementConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyStatementConfig_base(rName), `
+resource "aws_s3_bucket_policy_statement" "test1" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "AllowLogging"
+  policy = data.aws_iam_policy_document.logging.json
+}
+
+data "aws_iam_policy_document" "test2" {
+  statement {
+    sid       = "AllowAccountRead"
+    actions   = ["s3:GetObject"]
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy_statement" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "AllowAccountRead"
+  policy = data.aws_iam_policy_document.test2.json
+}
+`)
+}
+
+func testAccBucketPolicyStatementConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyStatementConfig_base(rName), `
+data "aws_iam_policy_document" "test1" {
+  statement {
+    sid       = "AllowLogging"
+    actions   = ["s3:PutObject"]
+    resources = ["${aws_s3_bucket.test.arn}/logs/*", "${aws_s3_bucket.test.arn}/audit/*"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["logging.s3.${data.aws_partition.current.dns_suffix}"]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceAccount"
+      values   = [data.aws_caller_identity.current.account_id]
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy_statement" "test1" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "AllowLogging"
+  policy = data.aws_iam_policy_document.test1.json
+}
+
+data "aws_iam_policy_document" "test2" {
+  statement {
+    sid       = "AllowAccountRead"
+    actions   = ["s3:GetObject"]
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy_statement" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "AllowAccountRead"
+  policy = data.aws_iam_policy_document.test2.json
+}
+`)
+}
+
+func testAccBucketPolicyStatementConfig_sidCollision(rName string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyStatementConfig_base(rName), `
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  policy = data.aws_iam_policy_document.logging.json
+}
+
+resource "aws_s3_bucket_policy_statement" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "AllowLogging"
+  policy = data.aws_iam_policy_document.logging.json
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`)
+}
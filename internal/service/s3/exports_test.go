@@ -19,6 +19,7 @@ var (
 	ResourceBucketObject                            = resourceBucketObject
 	ResourceBucketOwnershipControls                 = resourceBucketOwnershipControls
 	ResourceBucketPolicy                            = resourceBucketPolicy
+	ResourceBucketPolicyStatement                   = newBucketPolicyStatementResource
 	ResourceBucketPublicAccessBlock                 = resourceBucketPublicAccessBlock
 	ResourceBucketReplicationConfiguration          = resourceBucketReplicationConfiguration
 	ResourceBucketRequestPaymentConfiguration       = resourceBucketRequestPaymentConfiguration
@@ -40,6 +41,7 @@ var (
 	FindBucketLifecycleConfiguration      = findBucketLifecycleConfiguration
 	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
 	FindBucketPolicy                      = findBucketPolicy
+	FindBucketPolicyStatementByTwoPartKey = findBucketPolicyStatementByTwoPartKey
 	FindBucketRequestPayment              = findBucketRequestPayment
 	FindBucketVersioning                  = findBucketVersioning
 	FindBucketWebsite                     = findBucketWebsite
@@ -21,10 +21,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -47,8 +48,13 @@ func newTableResource(_ context.Context) (resource.ResourceWithConfigure, error)
 
 const (
 	ResNameTable = "Table"
+
+	// tableImportedPrivateKey marks a table whose Iceberg metadata is to be read back after import.
+	tableImportedPrivateKey = "imported"
 )
 
+var icebergMetadataPath = path.Root("metadata").AtListIndex(0).AtName("iceberg").AtListIndex(0)
+
 type tableResource struct {
 	framework.ResourceWithModel[tableResourceModel]
 }
@@ -161,6 +167,44 @@ func (r *tableResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							CustomType:  fwtypes.NewListNestedObjectTypeOf[icebergMetadataModel](ctx),
 							NestedObject: schema.NestedBlockObject{
 								Blocks: map[string]schema.Block{
+									"partition_spec": schema.ListNestedBlock{
+										Description: "Partition spec for the Iceberg table.",
+										CustomType:  fwtypes.NewListNestedObjectTypeOf[icebergPartitionSpecModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												names.AttrField: schema.ListNestedBlock{
+													Description: "List of partition fields for the Iceberg table.",
+													CustomType:  fwtypes.NewListNestedObjectTypeOf[icebergPartitionFieldModel](ctx),
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrName: schema.StringAttribute{
+																Optional:    true,
+																Description: "The name of the partition field. Defaults to a name derived from the source field and transform.",
+															},
+															"source_name": schema.StringAttribute{
+																Required:    true,
+																Description: "The name of the schema field that the partition field is derived from.",
+															},
+															"transform": schema.StringAttribute{
+																Required:    true,
+																Description: "The transform applied to the source field.",
+																Validators: []validator.String{
+																	icebergTransformValidator,
+																},
+															},
+														},
+													},
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+												},
+											},
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+									},
 									names.AttrSchema: schema.ListNestedBlock{
 										Description: "Schema configuration for the Iceberg table.",
 										NestedObject: schema.NestedBlockObject{
@@ -172,24 +216,18 @@ func (r *tableResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															names.AttrName: schema.StringAttribute{
 																Required:    true,
 																Description: "The name of the field.",
-																PlanModifiers: []planmodifier.String{
-																	stringplanmodifier.RequiresReplace(),
-																},
 															},
 															"required": schema.BoolAttribute{
 																Optional:    true,
 																Computed:    true,
 																Default:     booldefault.StaticBool(false),
 																Description: "A Boolean value that specifies whether values are required for each row in this field. Default: false.",
-																PlanModifiers: []planmodifier.Bool{
-																	boolplanmodifier.RequiresReplace(),
-																},
 															},
 															names.AttrType: schema.StringAttribute{
 																Required:    true,
 																Description: "The field type. S3 Tables supports all Apache Iceberg primitive types.",
-																PlanModifiers: []planmodifier.String{
-																	stringplanmodifier.RequiresReplace(),
+																Validators: []validator.String{
+																	icebergTypeValidator,
 																},
 															},
 														},
@@ -198,9 +236,6 @@ func (r *tableResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														listvalidator.IsRequired(),
 														listvalidator.SizeAtLeast(1),
 													},
-													PlanModifiers: []planmodifier.List{
-														listplanmodifier.RequiresReplace(),
-													},
 												},
 											},
 										},
@@ -208,8 +243,59 @@ func (r *tableResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											listvalidator.IsRequired(),
 											listvalidator.SizeBetween(1, 1),
 										},
-										PlanModifiers: []planmodifier.List{
-											listplanmodifier.RequiresReplace(),
+									},
+									"sort_order": schema.ListNestedBlock{
+										Description: "Sort order for the Iceberg table.",
+										CustomType:  fwtypes.NewListNestedObjectTypeOf[icebergSortOrderModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												names.AttrField: schema.ListNestedBlock{
+													Description: "List of sort fields for the Iceberg table.",
+													CustomType:  fwtypes.NewListNestedObjectTypeOf[icebergSortFieldModel](ctx),
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"direction": schema.StringAttribute{
+																Optional:    true,
+																Computed:    true,
+																Default:     stringdefault.StaticString(icebergSortDirectionAsc),
+																Description: "The sort direction. Default: asc.",
+																Validators: []validator.String{
+																	stringvalidator.OneOf(icebergSortDirections()...),
+																},
+															},
+															"null_order": schema.StringAttribute{
+																Optional:    true,
+																Computed:    true,
+																Default:     stringdefault.StaticString(icebergNullOrderNullsFirst),
+																Description: "The position of null values. Default: nulls-first.",
+																Validators: []validator.String{
+																	stringvalidator.OneOf(icebergNullOrders()...),
+																},
+															},
+															"source_name": schema.StringAttribute{
+																Required:    true,
+																Description: "The name of the schema field to sort by.",
+															},
+															"transform": schema.StringAttribute{
+																Optional:    true,
+																Computed:    true,
+																Default:     stringdefault.StaticString(icebergTransformIdentity),
+																Description: "The transform applied to the source field. Default: identity.",
+																Validators: []validator.String{
+																	icebergTransformValidator,
+																},
+															},
+														},
+													},
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+												},
+											},
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
 										},
 									},
 								},
@@ -218,9 +304,6 @@ func (r *tableResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								listvalidator.IsRequired(),
 								listvalidator.SizeBetween(1, 1),
 							},
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
 						},
 					},
 				},
@@ -228,7 +311,18 @@ func (r *tableResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					listvalidator.SizeAtMost(1),
 				},
 				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+					// Schema evolution, partition spec and sort order changes are applied in place,
+					// but adding or removing the table metadata requires recreating the table.
+					listplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+							if req.PlanValue.IsUnknown() {
+								return
+							}
+							resp.RequiresReplace = len(req.StateValue.Elements()) == 0 || len(req.PlanValue.Elements()) == 0
+						},
+						"Adding or removing table metadata requires replacement.",
+						"Adding or removing table metadata requires replacement.",
+					),
 				},
 			},
 		},
@@ -273,6 +367,24 @@ func (r *tableResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// The partition spec and sort order cannot be set on create, so they are committed as a new metadata version.
+	def, ok, d := plan.icebergDefinition(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if ok && def.hasLayout() {
+		err := updateTableIcebergMetadata(ctx, conn, r.Meta().S3Client(ctx), plan.TableBucketARN.ValueString(), plan.Namespace.ValueString(), plan.Name.ValueString(), def)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.S3Tables, create.ErrActionCreating, ResNameTable, plan.Name.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	if !plan.MaintenanceConfiguration.IsUnknown() && !plan.MaintenanceConfiguration.IsNull() {
 		mc, d := plan.MaintenanceConfiguration.ToPtr(ctx)
 		resp.Diagnostics.Append(d...)
@@ -420,6 +532,40 @@ func (r *tableResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	state.Namespace = types.StringValue(out.Namespace[0])
 
+	// Iceberg metadata is read back from the table's current metadata file if it is managed, or the table is being imported.
+	imported, d := req.Private.GetKey(ctx, tableImportedPrivateKey)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, hasIcebergMetadata, d := state.icebergDefinition(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if metadataLocation := state.MetadataLocation.ValueString(); metadataLocation != "" && (hasIcebergMetadata || imported != nil) {
+		def, err := findTableIcebergDefinition(ctx, r.Meta().S3Client(ctx), metadataLocation)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, ResNameTable, state.Name.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if hasIcebergMetadata {
+			resp.Diagnostics.Append(state.flattenIcebergLayout(ctx, def)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		} else if len(def.fields) > 0 {
+			state.Metadata = flattenIcebergTableDefinition(ctx, def)
+		}
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, tableImportedPrivateKey, nil)...)
+
 	awsMaintenanceConfig, err := conn.GetTableMaintenanceConfiguration(ctx, &s3tables.GetTableMaintenanceConfigurationInput{
 		Name:           state.Name.ValueStringPointer(),
 		Namespace:      state.Namespace.ValueStringPointer(),
@@ -500,6 +646,25 @@ func (r *tableResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	if !plan.Metadata.Equal(state.Metadata) {
+		def, ok, d := plan.icebergDefinition(ctx)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if ok {
+			err := updateTableIcebergMetadata(ctx, conn, r.Meta().S3Client(ctx), plan.TableBucketARN.ValueString(), plan.Namespace.ValueString(), plan.Name.ValueString(), def)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.S3Tables, create.ErrActionUpdating, ResNameTable, plan.Name.String(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
 	if !plan.MaintenanceConfiguration.Equal(state.MaintenanceConfiguration) {
 		planMC, d := plan.MaintenanceConfiguration.ToPtr(ctx)
 		resp.Diagnostics.Append(d...)
@@ -641,6 +806,71 @@ func (r *tableResource) ImportState(ctx context.Context, req resource.ImportStat
 	}
 
 	identifier.PopulateState(ctx, &resp.State, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, tableImportedPrivateKey, []byte(`true`))...)
+}
+
+func (r *tableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data tableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	def, ok, d := data.icebergDefinition(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	for _, problem := range validateIcebergTableDefinition(def) {
+		resp.Diagnostics.AddAttributeError(
+			icebergMetadataPath,
+			"Invalid Iceberg Table Definition",
+			problem,
+		)
+	}
+}
+
+func (r *tableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state tableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Metadata.Equal(state.Metadata) {
+		return
+	}
+
+	// A new metadata version is committed when the table metadata changes in place.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("metadata_location"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version_token"), types.StringUnknown())...)
+
+	n, ok, d := plan.icebergDefinition(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	o, ok, d := state.icebergDefinition(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	if problems := checkIcebergSchemaEvolution(o.fields, n.fields); len(problems) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			icebergMetadataPath.AtName(names.AttrSchema),
+			"Incompatible Iceberg Schema Change",
+			fmt.Sprintf("The schema of table %s cannot be changed in place:\n  - %s\n\nOnly additive schema changes are supported. To make this change, recreate the table.", plan.Name.ValueString(), strings.Join(problems, "\n  - ")),
+		)
+	}
 }
 
 func findTable(ctx context.Context, conn *s3tables.Client, bucketARN, namespace, name string) (*s3tables.GetTableOutput, error) {
@@ -941,7 +1171,9 @@ type tableMetadataModel struct {
 }
 
 type icebergMetadataModel struct {
-	Schema fwtypes.ListNestedObjectValueOf[icebergSchemaModel] `tfsdk:"schema"`
+	PartitionSpec fwtypes.ListNestedObjectValueOf[icebergPartitionSpecModel] `tfsdk:"partition_spec" autoflex:"-"`
+	Schema        fwtypes.ListNestedObjectValueOf[icebergSchemaModel]        `tfsdk:"schema"`
+	SortOrder     fwtypes.ListNestedObjectValueOf[icebergSortOrderModel]     `tfsdk:"sort_order" autoflex:"-"`
 }
 
 type icebergSchemaModel struct {
@@ -954,10 +1186,169 @@ type icebergSchemaFieldModel struct {
 	Type     types.String `tfsdk:"type"`
 }
 
+type icebergPartitionSpecModel struct {
+	Fields fwtypes.ListNestedObjectValueOf[icebergPartitionFieldModel] `tfsdk:"field"`
+}
+
+type icebergPartitionFieldModel struct {
+	Name       types.String `tfsdk:"name"`
+	SourceName types.String `tfsdk:"source_name"`
+	Transform  types.String `tfsdk:"transform"`
+}
+
+type icebergSortOrderModel struct {
+	Fields fwtypes.ListNestedObjectValueOf[icebergSortFieldModel] `tfsdk:"field"`
+}
+
+type icebergSortFieldModel struct {
+	Direction  types.String `tfsdk:"direction"`
+	NullOrder  types.String `tfsdk:"null_order"`
+	SourceName types.String `tfsdk:"source_name"`
+	Transform  types.String `tfsdk:"transform"`
+}
+
 var (
 	_ flex.Expander = tableMetadataModel{}
 )
 
+// icebergDefinition returns the Iceberg table definition configured in the table metadata.
+// The returned boolean is false if there is no Iceberg metadata or it is not yet known.
+func (m tableMetadataModel) icebergDefinition(ctx context.Context) (icebergTableDefinition, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.Iceberg.IsUnknown() {
+		return icebergTableDefinition{}, false, diags
+	}
+
+	iceberg, d := m.Iceberg.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || iceberg == nil {
+		return icebergTableDefinition{}, false, diags
+	}
+
+	return iceberg.definition(ctx)
+}
+
+// icebergDefinition returns the Iceberg table definition configured in the resource.
+// The returned boolean is false if there is no Iceberg metadata or it is not yet known.
+func (m tableResourceModel) icebergDefinition(ctx context.Context) (icebergTableDefinition, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.Metadata.IsUnknown() {
+		return icebergTableDefinition{}, false, diags
+	}
+
+	metadata, d := m.Metadata.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || metadata == nil {
+		return icebergTableDefinition{}, false, diags
+	}
+
+	return metadata.icebergDefinition(ctx)
+}
+
+// flattenIcebergLayout sets the partition spec and sort order in the resource's Iceberg metadata to those of the table definition.
+// Values that are equivalent to the table definition are left unchanged.
+func (m *tableResourceModel) flattenIcebergLayout(ctx context.Context, def icebergTableDefinition) diag.Diagnostics { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
+	var diags diag.Diagnostics
+
+	metadata, d := m.Metadata.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || metadata == nil {
+		return diags
+	}
+
+	iceberg, d := metadata.Iceberg.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || iceberg == nil {
+		return diags
+	}
+
+	current, _, d := iceberg.definition(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	changed := false
+	if !icebergPartitionFieldsEqual(current.partitionFields, def.partitionFields) {
+		iceberg.PartitionSpec = flattenIcebergPartitionSpec(ctx, def.partitionFields)
+		changed = true
+	}
+	if !icebergSortFieldsEqual(current.sortFields, def.sortFields) {
+		iceberg.SortOrder = flattenIcebergSortOrder(ctx, def.sortFields)
+		changed = true
+	}
+
+	if !changed {
+		return diags
+	}
+
+	metadata.Iceberg = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, iceberg)
+	m.Metadata = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, metadata)
+
+	return diags
+}
+
+func flattenIcebergTableDefinition(ctx context.Context, def icebergTableDefinition) fwtypes.ListNestedObjectValueOf[tableMetadataModel] { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
+	fields := tfslices.ApplyToAll(def.fields, func(v icebergFieldDefinition) icebergSchemaFieldModel {
+		return icebergSchemaFieldModel{
+			Name:     types.StringValue(v.name),
+			Required: types.BoolValue(v.required),
+			Type:     types.StringValue(v.typ),
+		}
+	})
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tableMetadataModel{
+		Iceberg: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &icebergMetadataModel{
+			PartitionSpec: flattenIcebergPartitionSpec(ctx, def.partitionFields),
+			Schema: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &icebergSchemaModel{
+				Fields: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, fields),
+			}),
+			SortOrder: flattenIcebergSortOrder(ctx, def.sortFields),
+		}),
+	})
+}
+
+func flattenIcebergPartitionSpec(ctx context.Context, fields []icebergPartitionFieldDefinition) fwtypes.ListNestedObjectValueOf[icebergPartitionSpecModel] { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
+	if len(fields) == 0 {
+		return fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []icebergPartitionSpecModel{})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &icebergPartitionSpecModel{
+		Fields: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tfslices.ApplyToAll(fields, func(v icebergPartitionFieldDefinition) icebergPartitionFieldModel {
+			// Omit names that Iceberg engines would derive from the source field and transform.
+			name := types.StringValue(v.name)
+			if t, err := parseIcebergTransform(v.transform); err == nil && v.name == t.defaultPartitionFieldName(v.sourceName) {
+				name = types.StringNull()
+			}
+
+			return icebergPartitionFieldModel{
+				Name:       name,
+				SourceName: types.StringValue(v.sourceName),
+				Transform:  types.StringValue(v.transform),
+			}
+		})),
+	})
+}
+
+func flattenIcebergSortOrder(ctx context.Context, fields []icebergSortFieldDefinition) fwtypes.ListNestedObjectValueOf[icebergSortOrderModel] { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
+	if len(fields) == 0 {
+		return fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []icebergSortOrderModel{})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &icebergSortOrderModel{
+		Fields: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tfslices.ApplyToAll(fields, func(v icebergSortFieldDefinition) icebergSortFieldModel {
+			return icebergSortFieldModel{
+				Direction:  types.StringValue(v.direction),
+				NullOrder:  types.StringValue(v.nullOrder),
+				SourceName: types.StringValue(v.sourceName),
+				Transform:  types.StringValue(v.transform),
+			}
+		})),
+	})
+}
+
 func (m tableMetadataModel) Expand(ctx context.Context) (out any, diags diag.Diagnostics) {
 	// If Iceberg metadata is set, expand it
 	if !m.Iceberg.IsNull() && !m.Iceberg.IsUnknown() {
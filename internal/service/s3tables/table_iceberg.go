@@ -0,0 +1,1148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Apache Iceberg table definitions.
+// See https://iceberg.apache.org/spec/#schemas-and-data-types and https://iceberg.apache.org/spec/#partition-transforms.
+
+const (
+	icebergTypeBinary      = "binary"
+	icebergTypeBoolean     = "boolean"
+	icebergTypeDate        = "date"
+	icebergTypeDecimal     = "decimal"
+	icebergTypeDouble      = "double"
+	icebergTypeFixed       = "fixed"
+	icebergTypeFloat       = "float"
+	icebergTypeInt         = "int"
+	icebergTypeLong        = "long"
+	icebergTypeString      = "string"
+	icebergTypeTime        = "time"
+	icebergTypeTimestamp   = "timestamp"
+	icebergTypeTimestampTZ = "timestamptz"
+	icebergTypeUUID        = "uuid"
+)
+
+const (
+	icebergTransformBucket   = "bucket"
+	icebergTransformDay      = "day"
+	icebergTransformHour     = "hour"
+	icebergTransformIdentity = "identity"
+	icebergTransformMonth    = "month"
+	icebergTransformTruncate = "truncate"
+	icebergTransformVoid     = "void"
+	icebergTransformYear     = "year"
+)
+
+const (
+	icebergSortDirectionAsc  = "asc"
+	icebergSortDirectionDesc = "desc"
+
+	icebergNullOrderNullsFirst = "nulls-first"
+	icebergNullOrderNullsLast  = "nulls-last"
+)
+
+const (
+	icebergMaxDecimalPrecision = 38
+
+	// Partition field IDs start at 1000.
+	icebergPartitionFieldIDStart = 1000
+	// Sort order ID 0 is reserved for the unsorted order.
+	icebergUnsortedOrderID = 0
+)
+
+func icebergPrimitiveTypes() []string {
+	return []string{
+		icebergTypeBinary,
+		icebergTypeBoolean,
+		icebergTypeDate,
+		icebergTypeDouble,
+		icebergTypeFloat,
+		icebergTypeInt,
+		icebergTypeLong,
+		icebergTypeString,
+		icebergTypeTime,
+		icebergTypeTimestamp,
+		icebergTypeTimestampTZ,
+		icebergTypeUUID,
+	}
+}
+
+func icebergSortDirections() []string {
+	return []string{icebergSortDirectionAsc, icebergSortDirectionDesc}
+}
+
+func icebergNullOrders() []string {
+	return []string{icebergNullOrderNullsFirst, icebergNullOrderNullsLast}
+}
+
+var (
+	icebergDecimalTypeRegexp       = regexache.MustCompile(`^decimal\(\s*(\d+)\s*,\s*(\d+)\s*\)$`)
+	icebergFixedTypeRegexp         = regexache.MustCompile(`^fixed\[\s*(\d+)\s*\]$`)
+	icebergBucketTransformRegexp   = regexache.MustCompile(`^bucket\[\s*(\d+)\s*\]$`)
+	icebergTruncateTransformRegexp = regexache.MustCompile(`^truncate\[\s*(\d+)\s*\]$`)
+)
+
+// icebergType is a parsed Iceberg primitive type.
+type icebergType struct {
+	name      string
+	precision int // decimal
+	scale     int // decimal
+	length    int // fixed
+}
+
+func parseIcebergType(s string) (icebergType, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if slices.Contains(icebergPrimitiveTypes(), s) {
+		return icebergType{name: s}, nil
+	}
+
+	if m := icebergDecimalTypeRegexp.FindStringSubmatch(s); m != nil {
+		precision, _ := strconv.Atoi(m[1])
+		scale, _ := strconv.Atoi(m[2])
+
+		if precision < 1 || precision > icebergMaxDecimalPrecision {
+			return icebergType{}, fmt.Errorf("decimal precision must be between 1 and %d, got %d", icebergMaxDecimalPrecision, precision)
+		}
+		if scale > precision {
+			return icebergType{}, fmt.Errorf("decimal scale (%d) must not be greater than precision (%d)", scale, precision)
+		}
+
+		return icebergType{name: icebergTypeDecimal, precision: precision, scale: scale}, nil
+	}
+
+	if m := icebergFixedTypeRegexp.FindStringSubmatch(s); m != nil {
+		length, _ := strconv.Atoi(m[1])
+
+		if length < 1 {
+			return icebergType{}, fmt.Errorf("fixed length must be at least 1, got %d", length)
+		}
+
+		return icebergType{name: icebergTypeFixed, length: length}, nil
+	}
+
+	return icebergType{}, fmt.Errorf("%q is not a supported Iceberg primitive type; expected one of %s, decimal(P,S) or fixed[L]", s, strings.Join(icebergPrimitiveTypes(), ", "))
+}
+
+// String returns the type's representation in Iceberg table metadata.
+func (t icebergType) String() string {
+	switch t.name {
+	case icebergTypeDecimal:
+		return fmt.Sprintf("decimal(%d, %d)", t.precision, t.scale)
+	case icebergTypeFixed:
+		return fmt.Sprintf("fixed[%d]", t.length)
+	default:
+		return t.name
+	}
+}
+
+// canPromoteTo returns whether a column of type t can be changed to type u without rewriting data.
+func (t icebergType) canPromoteTo(u icebergType) bool {
+	switch {
+	case t == u:
+		return true
+	case t.name == icebergTypeInt && u.name == icebergTypeLong:
+		return true
+	case t.name == icebergTypeFloat && u.name == icebergTypeDouble:
+		return true
+	case t.name == icebergTypeDecimal && u.name == icebergTypeDecimal:
+		return t.scale == u.scale && u.precision >= t.precision
+	default:
+		return false
+	}
+}
+
+// icebergTransform is a parsed Iceberg partition or sort transform.
+type icebergTransform struct {
+	name  string
+	width int // bucket or truncate
+}
+
+func parseIcebergTransform(s string) (icebergTransform, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	switch s {
+	case icebergTransformDay, icebergTransformHour, icebergTransformIdentity, icebergTransformMonth, icebergTransformVoid, icebergTransformYear:
+		return icebergTransform{name: s}, nil
+	}
+
+	if m := icebergBucketTransformRegexp.FindStringSubmatch(s); m != nil {
+		return newIcebergTransformWithWidth(icebergTransformBucket, m[1])
+	}
+
+	if m := icebergTruncateTransformRegexp.FindStringSubmatch(s); m != nil {
+		return newIcebergTransformWithWidth(icebergTransformTruncate, m[1])
+	}
+
+	return icebergTransform{}, fmt.Errorf("%q is not a supported Iceberg transform; expected one of identity, year, month, day, hour, bucket[N], truncate[W] or void", s)
+}
+
+func newIcebergTransformWithWidth(name, s string) (icebergTransform, error) {
+	width, _ := strconv.Atoi(s)
+
+	if width < 1 {
+		return icebergTransform{}, fmt.Errorf("%s width must be at least 1, got %d", name, width)
+	}
+
+	return icebergTransform{name: name, width: width}, nil
+}
+
+// String returns the transform's representation in Iceberg table metadata.
+func (t icebergTransform) String() string {
+	switch t.name {
+	case icebergTransformBucket, icebergTransformTruncate:
+		return fmt.Sprintf("%s[%d]", t.name, t.width)
+	default:
+		return t.name
+	}
+}
+
+// appliesTo returns whether the transform can be applied to a source column of the specified type.
+func (t icebergTransform) appliesTo(typ icebergType) bool {
+	switch t.name {
+	case icebergTransformIdentity, icebergTransformVoid:
+		return true
+	case icebergTransformBucket:
+		return !slices.Contains([]string{icebergTypeBoolean, icebergTypeDouble, icebergTypeFloat}, typ.name)
+	case icebergTransformTruncate:
+		return slices.Contains([]string{icebergTypeBinary, icebergTypeDecimal, icebergTypeInt, icebergTypeLong, icebergTypeString}, typ.name)
+	case icebergTransformYear, icebergTransformMonth, icebergTransformDay:
+		return slices.Contains([]string{icebergTypeDate, icebergTypeTimestamp, icebergTypeTimestampTZ}, typ.name)
+	case icebergTransformHour:
+		return slices.Contains([]string{icebergTypeTimestamp, icebergTypeTimestampTZ}, typ.name)
+	default:
+		return false
+	}
+}
+
+// defaultPartitionFieldName returns the partition field name that Iceberg engines use for the transform of a source column.
+func (t icebergTransform) defaultPartitionFieldName(sourceName string) string {
+	switch t.name {
+	case icebergTransformIdentity:
+		return sourceName
+	case icebergTransformTruncate:
+		return sourceName + "_trunc"
+	case icebergTransformVoid:
+		return sourceName + "_null"
+	default:
+		return sourceName + "_" + t.name
+	}
+}
+
+// icebergTableDefinition is the Terraform-managed part of an Iceberg table's metadata.
+type icebergTableDefinition struct {
+	fields          []icebergFieldDefinition
+	partitionFields []icebergPartitionFieldDefinition
+	sortFields      []icebergSortFieldDefinition
+}
+
+type icebergFieldDefinition struct {
+	name     string
+	required bool
+	typ      string
+}
+
+type icebergPartitionFieldDefinition struct {
+	name       string
+	sourceName string
+	transform  string
+}
+
+type icebergSortFieldDefinition struct {
+	direction  string
+	nullOrder  string
+	sourceName string
+	transform  string
+}
+
+// definition returns the table definition configured in the model.
+// The returned boolean is false if any part of the definition is not yet known.
+func (m icebergMetadataModel) definition(ctx context.Context) (icebergTableDefinition, bool, diag.Diagnostics) {
+	var def icebergTableDefinition
+	var diags diag.Diagnostics
+
+	if m.Schema.IsUnknown() || m.PartitionSpec.IsUnknown() || m.SortOrder.IsUnknown() {
+		return def, false, diags
+	}
+
+	schema, d := m.Schema.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return def, false, diags
+	}
+
+	if schema != nil {
+		if schema.Fields.IsUnknown() {
+			return def, false, diags
+		}
+
+		fields, d := schema.Fields.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return def, false, diags
+		}
+
+		for _, field := range fields {
+			if field.Name.IsUnknown() || field.Required.IsUnknown() || field.Type.IsUnknown() {
+				return def, false, diags
+			}
+
+			def.fields = append(def.fields, icebergFieldDefinition{
+				name:     field.Name.ValueString(),
+				required: field.Required.ValueBool(),
+				typ:      field.Type.ValueString(),
+			})
+		}
+	}
+
+	partitionSpec, d := m.PartitionSpec.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return def, false, diags
+	}
+
+	if partitionSpec != nil {
+		if partitionSpec.Fields.IsUnknown() {
+			return def, false, diags
+		}
+
+		fields, d := partitionSpec.Fields.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return def, false, diags
+		}
+
+		for _, field := range fields {
+			if field.Name.IsUnknown() || field.SourceName.IsUnknown() || field.Transform.IsUnknown() {
+				return def, false, diags
+			}
+
+			def.partitionFields = append(def.partitionFields, icebergPartitionFieldDefinition{
+				name:       field.Name.ValueString(),
+				sourceName: field.SourceName.ValueString(),
+				transform:  field.Transform.ValueString(),
+			})
+		}
+	}
+
+	sortOrder, d := m.SortOrder.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return def, false, diags
+	}
+
+	if sortOrder != nil {
+		if sortOrder.Fields.IsUnknown() {
+			return def, false, diags
+		}
+
+		fields, d := sortOrder.Fields.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return def, false, diags
+		}
+
+		for _, field := range fields {
+			if field.Direction.IsUnknown() || field.NullOrder.IsUnknown() || field.SourceName.IsUnknown() || field.Transform.IsUnknown() {
+				return def, false, diags
+			}
+
+			def.sortFields = append(def.sortFields, icebergSortFieldDefinition{
+				direction:  field.Direction.ValueString(),
+				nullOrder:  field.NullOrder.ValueString(),
+				sourceName: field.SourceName.ValueString(),
+				transform:  field.Transform.ValueString(),
+			})
+		}
+	}
+
+	return def, true, diags
+}
+
+// hasLayout returns whether the definition declares a partition spec or sort order.
+func (def icebergTableDefinition) hasLayout() bool {
+	return len(def.partitionFields) > 0 || len(def.sortFields) > 0
+}
+
+// validateIcebergTableDefinition returns a description of each problem found in a table definition.
+// Field types and transforms that cannot be parsed are reported by attribute validators and are not repeated here.
+func validateIcebergTableDefinition(def icebergTableDefinition) []string {
+	var problems []string
+
+	types := make(map[string]icebergType, len(def.fields))
+	for _, field := range def.fields {
+		if _, ok := types[field.name]; ok {
+			problems = append(problems, fmt.Sprintf("schema field %q is declared more than once", field.name))
+			continue
+		}
+
+		typ, err := parseIcebergType(field.typ)
+		if err != nil {
+			typ = icebergType{}
+		}
+		types[field.name] = typ
+	}
+
+	checkSource := func(kind, sourceName, transform string) {
+		typ, ok := types[sourceName]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s field source_name %q does not match a schema field", kind, sourceName))
+			return
+		}
+
+		t, err := parseIcebergTransform(transform)
+		if err != nil || typ.name == "" {
+			return
+		}
+
+		if !t.appliesTo(typ) {
+			problems = append(problems, fmt.Sprintf("%s transform %q cannot be applied to schema field %q of type %s", kind, t, sourceName, typ))
+		}
+	}
+
+	partitionNames := make(map[string]struct{}, len(def.partitionFields))
+	for _, field := range def.partitionFields {
+		checkSource("partition", field.sourceName, field.transform)
+
+		t, err := parseIcebergTransform(field.transform)
+		if err != nil {
+			continue
+		}
+
+		name := field.name
+		if name == "" {
+			name = t.defaultPartitionFieldName(field.sourceName)
+		}
+
+		if _, ok := partitionNames[name]; ok {
+			problems = append(problems, fmt.Sprintf("partition field name %q is used more than once", name))
+		}
+		partitionNames[name] = struct{}{}
+
+		// Only identity partitions may share the name of their source field.
+		if _, ok := types[name]; ok && (t.name != icebergTransformIdentity || name != field.sourceName) {
+			problems = append(problems, fmt.Sprintf("partition field name %q conflicts with a schema field", name))
+		}
+	}
+
+	for _, field := range def.sortFields {
+		checkSource("sort", field.sourceName, field.transform)
+	}
+
+	return problems
+}
+
+// checkIcebergSchemaEvolution returns a description of each change between two table schemas that cannot be applied in place.
+// Columns may be added as optional, reordered, made optional, or have their types promoted.
+func checkIcebergSchemaEvolution(old, new []icebergFieldDefinition) []string {
+	var problems []string
+
+	newFields := make(map[string]icebergFieldDefinition, len(new))
+	for _, field := range new {
+		newFields[field.name] = field
+	}
+
+	oldFields := make(map[string]icebergFieldDefinition, len(old))
+	for _, o := range old {
+		oldFields[o.name] = o
+
+		n, ok := newFields[o.name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("field %q is removed; dropping or renaming columns is not supported", o.name))
+			continue
+		}
+
+		if !o.required && n.required {
+			problems = append(problems, fmt.Sprintf("field %q is changed from optional to required", o.name))
+		}
+
+		ot, err := parseIcebergType(o.typ)
+		if err != nil {
+			continue
+		}
+		nt, err := parseIcebergType(n.typ)
+		if err != nil {
+			continue
+		}
+
+		if !ot.canPromoteTo(nt) {
+			problems = append(problems, fmt.Sprintf("field %q type cannot be changed from %s to %s; only int to long, float to double and widening decimal precision are supported", o.name, ot, nt))
+		}
+	}
+
+	for _, n := range new {
+		if _, ok := oldFields[n.name]; !ok && n.required {
+			problems = append(problems, fmt.Sprintf("field %q is added as required; new fields must be optional", n.name))
+		}
+	}
+
+	return problems
+}
+
+// Iceberg table metadata JSON.
+// See https://iceberg.apache.org/spec/#table-metadata-fields.
+// Only the parts of the metadata that are changed are decoded; everything else is preserved as-is.
+
+type icebergMetadataSchema struct {
+	Fields             []icebergMetadataField `json:"fields"`
+	IdentifierFieldIDs []int                  `json:"identifier-field-ids,omitempty"`
+	SchemaID           int                    `json:"schema-id"`
+	Type               string                 `json:"type"`
+}
+
+type icebergMetadataField struct {
+	Doc            string          `json:"doc,omitempty"`
+	ID             int             `json:"id"`
+	InitialDefault json.RawMessage `json:"initial-default,omitempty"`
+	Name           string          `json:"name"`
+	Required       bool            `json:"required"`
+	Type           json.RawMessage `json:"type"`
+	WriteDefault   json.RawMessage `json:"write-default,omitempty"`
+}
+
+type icebergMetadataPartitionSpec struct {
+	Fields []icebergMetadataPartitionField `json:"fields"`
+	SpecID int                             `json:"spec-id"`
+}
+
+type icebergMetadataPartitionField struct {
+	FieldID   int    `json:"field-id"`
+	Name      string `json:"name"`
+	SourceID  int    `json:"source-id"`
+	Transform string `json:"transform"`
+}
+
+type icebergMetadataSortOrder struct {
+	Fields  []icebergMetadataSortField `json:"fields"`
+	OrderID int                        `json:"order-id"`
+}
+
+type icebergMetadataSortField struct {
+	Direction string `json:"direction"`
+	NullOrder string `json:"null-order"`
+	SourceID  int    `json:"source-id"`
+	Transform string `json:"transform"`
+}
+
+type icebergMetadataLogEntry struct {
+	MetadataFile string `json:"metadata-file"`
+	TimestampMS  int64  `json:"timestamp-ms"`
+}
+
+// icebergTableMetadata wraps the raw top-level fields of an Iceberg table metadata file.
+type icebergTableMetadata map[string]json.RawMessage
+
+func (m icebergTableMetadata) get(key string, v any) error {
+	raw, ok := m[key]
+	if !ok {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("decoding Iceberg table metadata field %q: %w", key, err)
+	}
+
+	return nil
+}
+
+func (m icebergTableMetadata) set(key string, v any) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encoding Iceberg table metadata field %q: %w", key, err)
+	}
+
+	m[key] = raw
+
+	return nil
+}
+
+// applyIcebergTableDefinition applies a table definition to the contents of an Iceberg table metadata file.
+// It returns the contents of the new metadata file, or nil if the metadata already matches the definition.
+// Columns in the current schema that are not part of the definition are preserved.
+func applyIcebergTableDefinition(in []byte, metadataLocation string, def icebergTableDefinition, now time.Time) ([]byte, error) {
+	var metadata icebergTableMetadata
+	if err := json.Unmarshal(in, &metadata); err != nil {
+		return nil, fmt.Errorf("decoding Iceberg table metadata: %w", err)
+	}
+
+	var formatVersion int
+	if err := metadata.get("format-version", &formatVersion); err != nil {
+		return nil, err
+	}
+	if formatVersion != 2 {
+		return nil, fmt.Errorf("unsupported Iceberg table format version: %d", formatVersion)
+	}
+
+	var (
+		schemas         []icebergMetadataSchema
+		currentSchemaID int
+		lastColumnID    int
+	)
+	if err := errors.Join(
+		metadata.get("schemas", &schemas),
+		metadata.get("current-schema-id", &currentSchemaID),
+		metadata.get("last-column-id", &lastColumnID),
+	); err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(schemas, func(v icebergMetadataSchema) bool {
+		return v.SchemaID == currentSchemaID
+	})
+	if i == -1 {
+		return nil, fmt.Errorf("current schema (%d) not found in Iceberg table metadata", currentSchemaID)
+	}
+	current := schemas[i]
+
+	fields := make([]icebergMetadataField, 0, len(def.fields))
+	for _, field := range def.fields {
+		typ, err := parseIcebergType(field.typ)
+		if err != nil {
+			return nil, err
+		}
+
+		rawType, err := json.Marshal(typ.String())
+		if err != nil {
+			return nil, err
+		}
+
+		if i := slices.IndexFunc(current.Fields, func(v icebergMetadataField) bool { return v.Name == field.name }); i != -1 {
+			f := current.Fields[i]
+
+			var currentType string
+			if json.Unmarshal(f.Type, &currentType) != nil {
+				return nil, fmt.Errorf("column %q of Iceberg table has a nested type", field.name)
+			}
+
+			if t, err := parseIcebergType(currentType); err != nil || t != typ {
+				f.Type = rawType
+			}
+			f.Required = field.required
+			fields = append(fields, f)
+
+			continue
+		}
+
+		lastColumnID++
+		fields = append(fields, icebergMetadataField{
+			ID:       lastColumnID,
+			Name:     field.name,
+			Required: field.required,
+			Type:     rawType,
+		})
+	}
+	for _, f := range current.Fields {
+		if !slices.ContainsFunc(fields, func(v icebergMetadataField) bool { return v.ID == f.ID }) {
+			fields = append(fields, f)
+		}
+	}
+
+	changed := false
+
+	if !icebergMetadataFieldsEqual(current.Fields, fields) {
+		schemaID := -1
+		for _, v := range schemas {
+			if icebergMetadataFieldsEqual(v.Fields, fields) {
+				schemaID = v.SchemaID
+				break
+			}
+		}
+
+		if schemaID == -1 {
+			for _, v := range schemas {
+				schemaID = max(schemaID, v.SchemaID)
+			}
+			schemaID++
+
+			schemas = append(schemas, icebergMetadataSchema{
+				Fields:             fields,
+				IdentifierFieldIDs: current.IdentifierFieldIDs,
+				SchemaID:           schemaID,
+				Type:               "struct",
+			})
+		}
+
+		if err := errors.Join(
+			metadata.set("schemas", schemas),
+			metadata.set("current-schema-id", schemaID),
+			metadata.set("last-column-id", lastColumnID),
+		); err != nil {
+			return nil, err
+		}
+
+		changed = true
+	}
+
+	columnIDs := make(map[string]int, len(fields))
+	for _, f := range fields {
+		columnIDs[f.Name] = f.ID
+	}
+	columnID := func(name string) (int, error) {
+		id, ok := columnIDs[name]
+		if !ok {
+			return 0, fmt.Errorf("column %q not found in Iceberg table schema", name)
+		}
+		return id, nil
+	}
+
+	var (
+		specs            []icebergMetadataPartitionSpec
+		defaultSpecID    int
+		lastPartitionID  = icebergPartitionFieldIDStart - 1
+		sortOrders       []icebergMetadataSortOrder
+		defaultSortOrder int
+	)
+	if err := errors.Join(
+		metadata.get("partition-specs", &specs),
+		metadata.get("default-spec-id", &defaultSpecID),
+		metadata.get("last-partition-id", &lastPartitionID),
+		metadata.get("sort-orders", &sortOrders),
+		metadata.get("default-sort-order-id", &defaultSortOrder),
+	); err != nil {
+		return nil, err
+	}
+
+	partitionFields := make([]icebergMetadataPartitionField, 0, len(def.partitionFields))
+	for _, field := range def.partitionFields {
+		t, err := parseIcebergTransform(field.transform)
+		if err != nil {
+			return nil, err
+		}
+
+		sourceID, err := columnID(field.sourceName)
+		if err != nil {
+			return nil, err
+		}
+
+		name := field.name
+		if name == "" {
+			name = t.defaultPartitionFieldName(field.sourceName)
+		}
+
+		// Reuse the field ID of an equivalent field in an earlier spec.
+		fieldID := 0
+		for _, spec := range specs {
+			for _, f := range spec.Fields {
+				if f.SourceID == sourceID && f.Transform == t.String() {
+					fieldID = f.FieldID
+				}
+			}
+		}
+		if fieldID == 0 {
+			lastPartitionID++
+			fieldID = lastPartitionID
+		}
+
+		partitionFields = append(partitionFields, icebergMetadataPartitionField{
+			FieldID:   fieldID,
+			Name:      name,
+			SourceID:  sourceID,
+			Transform: t.String(),
+		})
+	}
+
+	if i := slices.IndexFunc(specs, func(v icebergMetadataPartitionSpec) bool { return v.SpecID == defaultSpecID }); i == -1 || !slices.Equal(specs[i].Fields, partitionFields) {
+		specID := -1
+		for _, v := range specs {
+			if slices.Equal(v.Fields, partitionFields) {
+				specID = v.SpecID
+				break
+			}
+		}
+
+		if specID == -1 {
+			for _, v := range specs {
+				specID = max(specID, v.SpecID)
+			}
+			specID++
+
+			specs = append(specs, icebergMetadataPartitionSpec{
+				Fields: partitionFields,
+				SpecID: specID,
+			})
+		}
+
+		if err := errors.Join(
+			metadata.set("partition-specs", specs),
+			metadata.set("default-spec-id", specID),
+			metadata.set("last-partition-id", lastPartitionID),
+		); err != nil {
+			return nil, err
+		}
+
+		changed = true
+	}
+
+	sortFields := make([]icebergMetadataSortField, 0, len(def.sortFields))
+	for _, field := range def.sortFields {
+		t, err := parseIcebergTransform(field.transform)
+		if err != nil {
+			return nil, err
+		}
+
+		sourceID, err := columnID(field.sourceName)
+		if err != nil {
+			return nil, err
+		}
+
+		sortFields = append(sortFields, icebergMetadataSortField{
+			Direction: field.direction,
+			NullOrder: field.nullOrder,
+			SourceID:  sourceID,
+			Transform: t.String(),
+		})
+	}
+
+	if i := slices.IndexFunc(sortOrders, func(v icebergMetadataSortOrder) bool { return v.OrderID == defaultSortOrder }); i == -1 || !slices.Equal(sortOrders[i].Fields, sortFields) {
+		orderID := -1
+		for _, v := range sortOrders {
+			if slices.Equal(v.Fields, sortFields) {
+				orderID = v.OrderID
+				break
+			}
+		}
+
+		if orderID == -1 {
+			orderID = icebergUnsortedOrderID
+			if len(sortFields) > 0 {
+				for _, v := range sortOrders {
+					orderID = max(orderID, v.OrderID)
+				}
+				orderID++
+			}
+
+			sortOrders = append(sortOrders, icebergMetadataSortOrder{
+				Fields:  sortFields,
+				OrderID: orderID,
+			})
+		}
+
+		if err := errors.Join(
+			metadata.set("sort-orders", sortOrders),
+			metadata.set("default-sort-order-id", orderID),
+		); err != nil {
+			return nil, err
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return nil, nil
+	}
+
+	var (
+		lastUpdatedMS int64
+		metadataLog   []icebergMetadataLogEntry
+	)
+	if err := errors.Join(
+		metadata.get("last-updated-ms", &lastUpdatedMS),
+		metadata.get("metadata-log", &metadataLog),
+	); err != nil {
+		return nil, err
+	}
+
+	metadataLog = append(metadataLog, icebergMetadataLogEntry{
+		MetadataFile: metadataLocation,
+		TimestampMS:  lastUpdatedMS,
+	})
+
+	if err := errors.Join(
+		metadata.set("last-updated-ms", max(now.UnixMilli(), lastUpdatedMS+1)),
+		metadata.set("metadata-log", metadataLog),
+	); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(metadata)
+}
+
+// readIcebergTableDefinition returns the table definition described by the current schema, default partition spec
+// and default sort order in the contents of an Iceberg table metadata file.
+// Columns with nested types cannot be configured and are not part of the definition.
+func readIcebergTableDefinition(in []byte) (icebergTableDefinition, error) {
+	var def icebergTableDefinition
+
+	var metadata icebergTableMetadata
+	if err := json.Unmarshal(in, &metadata); err != nil {
+		return def, fmt.Errorf("decoding Iceberg table metadata: %w", err)
+	}
+
+	var (
+		schemas          []icebergMetadataSchema
+		currentSchemaID  int
+		specs            []icebergMetadataPartitionSpec
+		defaultSpecID    int
+		sortOrders       []icebergMetadataSortOrder
+		defaultSortOrder int
+	)
+	if err := errors.Join(
+		metadata.get("schemas", &schemas),
+		metadata.get("current-schema-id", &currentSchemaID),
+		metadata.get("partition-specs", &specs),
+		metadata.get("default-spec-id", &defaultSpecID),
+		metadata.get("sort-orders", &sortOrders),
+		metadata.get("default-sort-order-id", &defaultSortOrder),
+	); err != nil {
+		return def, err
+	}
+
+	i := slices.IndexFunc(schemas, func(v icebergMetadataSchema) bool {
+		return v.SchemaID == currentSchemaID
+	})
+	if i == -1 {
+		return def, fmt.Errorf("current schema (%d) not found in Iceberg table metadata", currentSchemaID)
+	}
+
+	columnNames := make(map[int]string, len(schemas[i].Fields))
+	for _, f := range schemas[i].Fields {
+		columnNames[f.ID] = f.Name
+
+		var typ string
+		if json.Unmarshal(f.Type, &typ) != nil {
+			continue
+		}
+
+		def.fields = append(def.fields, icebergFieldDefinition{
+			name:     f.Name,
+			required: f.Required,
+			typ:      typ,
+		})
+	}
+	columnName := func(id int) (string, error) {
+		name, ok := columnNames[id]
+		if !ok {
+			return "", fmt.Errorf("column (%d) not found in Iceberg table schema", id)
+		}
+		return name, nil
+	}
+
+	if i := slices.IndexFunc(specs, func(v icebergMetadataPartitionSpec) bool { return v.SpecID == defaultSpecID }); i != -1 {
+		for _, f := range specs[i].Fields {
+			sourceName, err := columnName(f.SourceID)
+			if err != nil {
+				return def, err
+			}
+
+			def.partitionFields = append(def.partitionFields, icebergPartitionFieldDefinition{
+				name:       f.Name,
+				sourceName: sourceName,
+				transform:  f.Transform,
+			})
+		}
+	}
+
+	if i := slices.IndexFunc(sortOrders, func(v icebergMetadataSortOrder) bool { return v.OrderID == defaultSortOrder }); i != -1 {
+		for _, f := range sortOrders[i].Fields {
+			sourceName, err := columnName(f.SourceID)
+			if err != nil {
+				return def, err
+			}
+
+			def.sortFields = append(def.sortFields, icebergSortFieldDefinition{
+				direction:  f.Direction,
+				nullOrder:  f.NullOrder,
+				sourceName: sourceName,
+				transform:  f.Transform,
+			})
+		}
+	}
+
+	return def, nil
+}
+
+// normalize returns the partition field with its transform in canonical form and its default name made explicit.
+func (f icebergPartitionFieldDefinition) normalize() icebergPartitionFieldDefinition {
+	if t, err := parseIcebergTransform(f.transform); err == nil {
+		f.transform = t.String()
+		if f.name == "" {
+			f.name = t.defaultPartitionFieldName(f.sourceName)
+		}
+	}
+
+	return f
+}
+
+// normalize returns the sort field with its transform in canonical form.
+func (f icebergSortFieldDefinition) normalize() icebergSortFieldDefinition {
+	if t, err := parseIcebergTransform(f.transform); err == nil {
+		f.transform = t.String()
+	}
+
+	return f
+}
+
+// icebergPartitionFieldsEqual returns whether two partition specs are equivalent.
+func icebergPartitionFieldsEqual(a, b []icebergPartitionFieldDefinition) bool {
+	return slices.EqualFunc(a, b, func(x, y icebergPartitionFieldDefinition) bool {
+		return x.normalize() == y.normalize()
+	})
+}
+
+// icebergSortFieldsEqual returns whether two sort orders are equivalent.
+func icebergSortFieldsEqual(a, b []icebergSortFieldDefinition) bool {
+	return slices.EqualFunc(a, b, func(x, y icebergSortFieldDefinition) bool {
+		return x.normalize() == y.normalize()
+	})
+}
+
+func icebergMetadataFieldsEqual(a, b []icebergMetadataField) bool {
+	return slices.EqualFunc(a, b, func(x, y icebergMetadataField) bool {
+		return x.ID == y.ID && x.Name == y.Name && x.Required == y.Required && bytes.Equal(x.Type, y.Type)
+	})
+}
+
+// nextIcebergMetadataLocation returns the location of the metadata file that follows the specified one,
+// using the <version>-<uuid>.metadata.json naming convention.
+func nextIcebergMetadataLocation(metadataLocation string) (string, error) {
+	i := strings.LastIndex(metadataLocation, "/")
+	if i == -1 {
+		return "", fmt.Errorf("invalid Iceberg metadata location: %q", metadataLocation)
+	}
+	dir, file := metadataLocation[:i], metadataLocation[i+1:]
+
+	version := 0
+	if prefix, _, ok := strings.Cut(file, "-"); ok {
+		if v, err := strconv.Atoi(prefix); err == nil {
+			version = v + 1
+		}
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%05d-%s.metadata.json", dir, version, id), nil
+}
+
+func parseS3URI(s string) (string, string, error) {
+	bucket, key, ok := strings.Cut(strings.TrimPrefix(s, "s3://"), "/")
+	if !ok || !strings.HasPrefix(s, "s3://") || bucket == "" || key == "" {
+		return "", "", fmt.Errorf("invalid S3 URI: %q", s)
+	}
+
+	return bucket, key, nil
+}
+
+// updateTableIcebergMetadata writes a new Iceberg metadata file for the table with the definition applied
+// and commits it as the table's current metadata.
+func updateTableIcebergMetadata(ctx context.Context, conn *s3tables.Client, s3Conn *s3.Client, bucketARN, namespace, name string, def icebergTableDefinition) error {
+	input := s3tables.GetTableMetadataLocationInput{
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(bucketARN),
+	}
+
+	output, err := conn.GetTableMetadataLocation(ctx, &input)
+	if err != nil {
+		return fmt.Errorf("reading metadata location: %w", err)
+	}
+
+	metadataLocation := aws.ToString(output.MetadataLocation)
+	if metadataLocation == "" {
+		return errors.New("table has no Iceberg metadata")
+	}
+
+	body, err := findTableIcebergMetadata(ctx, s3Conn, metadataLocation)
+	if err != nil {
+		return err
+	}
+
+	body, err = applyIcebergTableDefinition(body, metadataLocation, def, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if body == nil {
+		return nil
+	}
+
+	newMetadataLocation, err := nextIcebergMetadataLocation(metadataLocation)
+	if err != nil {
+		return err
+	}
+
+	bucket, key, err := parseS3URI(newMetadataLocation)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3Conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:        bytes.NewReader(body),
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String("application/json"),
+		Key:         aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("writing Iceberg metadata (%s): %w", newMetadataLocation, err)
+	}
+
+	_, err = conn.UpdateTableMetadataLocation(ctx, &s3tables.UpdateTableMetadataLocationInput{
+		MetadataLocation: aws.String(newMetadataLocation),
+		Name:             aws.String(name),
+		Namespace:        aws.String(namespace),
+		TableBucketARN:   aws.String(bucketARN),
+		VersionToken:     output.VersionToken,
+	})
+	if err != nil {
+		return fmt.Errorf("updating metadata location: %w", err)
+	}
+
+	return nil
+}
+
+// findTableIcebergMetadata returns the contents of the Iceberg metadata file at the specified location.
+func findTableIcebergMetadata(ctx context.Context, conn *s3.Client, metadataLocation string) ([]byte, error) {
+	bucket, key, err := parseS3URI(metadataLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := conn.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading Iceberg metadata (%s): %w", metadataLocation, err)
+	}
+	defer object.Body.Close()
+
+	body, err := io.ReadAll(object.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading Iceberg metadata (%s): %w", metadataLocation, err)
+	}
+
+	return body, nil
+}
+
+// findTableIcebergDefinition returns the table definition described by the Iceberg metadata file at the specified location.
+func findTableIcebergDefinition(ctx context.Context, conn *s3.Client, metadataLocation string) (icebergTableDefinition, error) {
+	body, err := findTableIcebergMetadata(ctx, conn, metadataLocation)
+	if err != nil {
+		return icebergTableDefinition{}, err
+	}
+
+	return readIcebergTableDefinition(body)
+}
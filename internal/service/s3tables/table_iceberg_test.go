@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseIcebergType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+		err      bool
+	}{
+		"primitive":         {input: "long", expected: "long"},
+		"upper case":        {input: "TimestampTZ", expected: "timestamptz"},
+		"decimal":           {input: "decimal(10,2)", expected: "decimal(10, 2)"},
+		"decimal spaces":    {input: "decimal( 10 , 2 )", expected: "decimal(10, 2)"},
+		"decimal precision": {input: "decimal(39,2)", err: true},
+		"decimal scale":     {input: "decimal(2,3)", err: true},
+		"fixed":             {input: "fixed[16]", expected: "fixed[16]"},
+		"fixed zero":        {input: "fixed[0]", err: true},
+		"fixed parentheses": {input: "fixed(16)", err: true},
+		"nested":            {input: "struct<a:int>", err: true},
+		"unknown":           {input: "varchar", err: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseIcebergType(testCase.input)
+
+			if testCase.err {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.String() != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateIcebergTableDefinition(t *testing.T) {
+	t.Parallel()
+
+	fields := []icebergFieldDefinition{
+		{name: "id", typ: "long", required: true},
+		{name: "name", typ: "string"},
+		{name: "price", typ: "double"},
+		{name: "created_at", typ: "timestamptz"},
+	}
+
+	testCases := map[string]struct {
+		def      icebergTableDefinition
+		expected []string
+	}{
+		"valid": {
+			def: icebergTableDefinition{
+				fields: fields,
+				partitionFields: []icebergPartitionFieldDefinition{
+					{sourceName: "created_at", transform: "day"},
+					{sourceName: "id", transform: "bucket[16]"},
+					{sourceName: "name", transform: "identity"},
+				},
+				sortFields: []icebergSortFieldDefinition{
+					{sourceName: "price", transform: "identity", direction: "desc", nullOrder: "nulls-last"},
+				},
+			},
+		},
+		"duplicate field": {
+			def: icebergTableDefinition{
+				fields: append(fields, icebergFieldDefinition{name: "id", typ: "int"}),
+			},
+			expected: []string{`schema field "id" is declared more than once`},
+		},
+		"unknown source": {
+			def: icebergTableDefinition{
+				fields: fields,
+				partitionFields: []icebergPartitionFieldDefinition{
+					{sourceName: "updated_at", transform: "day"},
+				},
+				sortFields: []icebergSortFieldDefinition{
+					{sourceName: "email", transform: "identity"},
+				},
+			},
+			expected: []string{
+				`partition field source_name "updated_at" does not match a schema field`,
+				`sort field source_name "email" does not match a schema field`,
+			},
+		},
+		"transform type": {
+			def: icebergTableDefinition{
+				fields: fields,
+				partitionFields: []icebergPartitionFieldDefinition{
+					{sourceName: "name", transform: "month"},
+					{sourceName: "price", transform: "bucket[4]"},
+				},
+			},
+			expected: []string{
+				`partition transform "month" cannot be applied to schema field "name" of type string`,
+				`partition transform "bucket[4]" cannot be applied to schema field "price" of type double`,
+			},
+		},
+		"partition names": {
+			def: icebergTableDefinition{
+				fields: fields,
+				partitionFields: []icebergPartitionFieldDefinition{
+					{sourceName: "created_at", transform: "day"},
+					{sourceName: "created_at", transform: "hour", name: "created_at_day"},
+					{sourceName: "id", transform: "truncate[10]", name: "name"},
+				},
+			},
+			expected: []string{
+				`partition field name "created_at_day" is used more than once`,
+				`partition field name "name" conflicts with a schema field`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateIcebergTableDefinition(testCase.def)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckIcebergSchemaEvolution(t *testing.T) {
+	t.Parallel()
+
+	old := []icebergFieldDefinition{
+		{name: "id", typ: "int", required: true},
+		{name: "name", typ: "string", required: true},
+		{name: "price", typ: "decimal(10,2)"},
+		{name: "score", typ: "float"},
+	}
+
+	testCases := map[string]struct {
+		new      []icebergFieldDefinition
+		expected []string
+	}{
+		"unchanged": {
+			new: old,
+		},
+		"additive": {
+			new: []icebergFieldDefinition{
+				{name: "id", typ: "long", required: true},
+				{name: "email", typ: "string"},
+				{name: "name", typ: "string"},
+				{name: "price", typ: "decimal(12, 2)"},
+				{name: "score", typ: "double"},
+			},
+		},
+		"incompatible": {
+			new: []icebergFieldDefinition{
+				{name: "id", typ: "string", required: true},
+				{name: "name", typ: "string", required: true},
+				{name: "price", typ: "decimal(12,3)", required: true},
+				{name: "email", typ: "string", required: true},
+			},
+			expected: []string{
+				`field "id" type cannot be changed from int to string; only int to long, float to double and widening decimal precision are supported`,
+				`field "price" is changed from optional to required`,
+				`field "price" type cannot be changed from decimal(10, 2) to decimal(12, 3); only int to long, float to double and widening decimal precision are supported`,
+				`field "score" is removed; dropping or renaming columns is not supported`,
+				`field "email" is added as required; new fields must be optional`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := checkIcebergSchemaEvolution(old, testCase.new)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyIcebergTableDefinition(t *testing.T) {
+	t.Parallel()
+
+	const (
+		metadataLocation = "s3://example--table-s3/metadata/00000-4c5ba7fa-e5a2-4a51-b4a1-2f5fb10c5a2e.metadata.json"
+		metadata         = `{
+  "format-version": 2,
+  "table-uuid": "9c12d441-03fe-4693-9a96-a0705ddf69c1",
+  "location": "s3://example--table-s3",
+  "last-sequence-number": 0,
+  "last-updated-ms": 1700000000000,
+  "last-column-id": 2,
+  "current-schema-id": 0,
+  "schemas": [{"type": "struct", "schema-id": 0, "fields": [
+    {"id": 1, "name": "id", "required": true, "type": "int"},
+    {"id": 2, "name": "created_at", "required": false, "type": "timestamptz"}
+  ]}],
+  "default-spec-id": 0,
+  "partition-specs": [{"spec-id": 0, "fields": []}],
+  "last-partition-id": 999,
+  "default-sort-order-id": 0,
+  "sort-orders": [{"order-id": 0, "fields": []}],
+  "properties": {"write.format.default": "parquet"},
+  "current-snapshot-id": 8744736658442914487,
+  "snapshots": [],
+  "snapshot-log": [],
+  "metadata-log": []
+}`
+	)
+
+	now := time.UnixMilli(1800000000000)
+
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		def := icebergTableDefinition{
+			fields: []icebergFieldDefinition{
+				{name: "id", typ: "int", required: true},
+				{name: "created_at", typ: "timestamptz"},
+			},
+		}
+
+		got, err := applyIcebergTableDefinition([]byte(metadata), metadataLocation, def, now)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != nil {
+			t.Errorf("expected no new metadata, got %s", got)
+		}
+	})
+
+	t.Run("evolve", func(t *testing.T) {
+		t.Parallel()
+
+		def := icebergTableDefinition{
+			fields: []icebergFieldDefinition{
+				{name: "id", typ: "long", required: true},
+				{name: "email", typ: "string"},
+				{name: "created_at", typ: "timestamptz"},
+			},
+			partitionFields: []icebergPartitionFieldDefinition{
+				{sourceName: "created_at", transform: "day"},
+				{sourceName: "id", transform: "bucket[16]", name: "id_shard"},
+			},
+			sortFields: []icebergSortFieldDefinition{
+				{sourceName: "email", transform: "identity", direction: "asc", nullOrder: "nulls-first"},
+			},
+		}
+
+		got, err := applyIcebergTableDefinition([]byte(metadata), metadataLocation, def, now)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var result struct {
+			CurrentSchemaID    int                            `json:"current-schema-id"`
+			CurrentSnapshotID  json.Number                    `json:"current-snapshot-id"`
+			DefaultSortOrderID int                            `json:"default-sort-order-id"`
+			DefaultSpecID      int                            `json:"default-spec-id"`
+			LastColumnID       int                            `json:"last-column-id"`
+			LastPartitionID    int                            `json:"last-partition-id"`
+			LastUpdatedMS      int64                          `json:"last-updated-ms"`
+			MetadataLog        []icebergMetadataLogEntry      `json:"metadata-log"`
+			PartitionSpecs     []icebergMetadataPartitionSpec `json:"partition-specs"`
+			Properties         map[string]string              `json:"properties"`
+			Schemas            []icebergMetadataSchema        `json:"schemas"`
+			SortOrders         []icebergMetadataSortOrder     `json:"sort-orders"`
+		}
+		decoder := json.NewDecoder(strings.NewReader(string(got)))
+		decoder.UseNumber()
+		if err := decoder.Decode(&result); err != nil {
+			t.Fatalf("decoding new metadata: %s", err)
+		}
+
+		if got, want := result.CurrentSnapshotID.String(), "8744736658442914487"; got != want {
+			t.Errorf("current-snapshot-id: expected %s, got %s", want, got)
+		}
+		if got, want := result.Properties["write.format.default"], "parquet"; got != want {
+			t.Errorf("properties: expected %s, got %s", want, got)
+		}
+		if got, want := result.LastUpdatedMS, now.UnixMilli(); got != want {
+			t.Errorf("last-updated-ms: expected %d, got %d", want, got)
+		}
+		if diff := cmp.Diff([]icebergMetadataLogEntry{{MetadataFile: metadataLocation, TimestampMS: 1700000000000}}, result.MetadataLog); diff != "" {
+			t.Errorf("metadata-log: unexpected diff (+wanted, -got): %s", diff)
+		}
+
+		if got, want := len(result.Schemas), 2; got != want {
+			t.Fatalf("schemas: expected %d, got %d", want, got)
+		}
+		if got, want := result.CurrentSchemaID, 1; got != want {
+			t.Errorf("current-schema-id: expected %d, got %d", want, got)
+		}
+		if got, want := result.LastColumnID, 3; got != want {
+			t.Errorf("last-column-id: expected %d, got %d", want, got)
+		}
+		var fields []string
+		for _, f := range result.Schemas[1].Fields {
+			fields = append(fields, strings.Join([]string{f.Name, string(f.Type)}, ":"))
+			if f.Name == "email" && f.ID != 3 {
+				t.Errorf("email: expected ID 3, got %d", f.ID)
+			}
+		}
+		if diff := cmp.Diff([]string{`id:"long"`, `email:"string"`, `created_at:"timestamptz"`}, fields); diff != "" {
+			t.Errorf("schema fields: unexpected diff (+wanted, -got): %s", diff)
+		}
+
+		if got, want := result.DefaultSpecID, 1; got != want {
+			t.Errorf("default-spec-id: expected %d, got %d", want, got)
+		}
+		if got, want := result.LastPartitionID, 1001; got != want {
+			t.Errorf("last-partition-id: expected %d, got %d", want, got)
+		}
+		if diff := cmp.Diff([]icebergMetadataPartitionField{
+			{FieldID: 1000, Name: "created_at_day", SourceID: 2, Transform: "day"},
+			{FieldID: 1001, Name: "id_shard", SourceID: 1, Transform: "bucket[16]"},
+		}, result.PartitionSpecs[1].Fields); diff != "" {
+			t.Errorf("partition spec: unexpected diff (+wanted, -got): %s", diff)
+		}
+
+		if got, want := result.DefaultSortOrderID, 1; got != want {
+			t.Errorf("default-sort-order-id: expected %d, got %d", want, got)
+		}
+		if diff := cmp.Diff([]icebergMetadataSortField{
+			{Direction: "asc", NullOrder: "nulls-first", SourceID: 3, Transform: "identity"},
+		}, result.SortOrders[1].Fields); diff != "" {
+			t.Errorf("sort order: unexpected diff (+wanted, -got): %s", diff)
+		}
+
+		// Reading the new metadata back yields the definition.
+		read, err := readIcebergTableDefinition(got)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if diff := cmp.Diff(def.fields, read.fields, cmp.AllowUnexported(icebergFieldDefinition{})); diff != "" {
+			t.Errorf("read schema fields: unexpected diff (+wanted, -got): %s", diff)
+		}
+		if !icebergPartitionFieldsEqual(def.partitionFields, read.partitionFields) {
+			t.Errorf("read partition spec: expected %v, got %v", def.partitionFields, read.partitionFields)
+		}
+		if !icebergSortFieldsEqual(def.sortFields, read.sortFields) {
+			t.Errorf("read sort order: expected %v, got %v", def.sortFields, read.sortFields)
+		}
+
+		// Reapplying the definition to the new metadata is a no-op.
+		again, err := applyIcebergTableDefinition(got, metadataLocation, def, now)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if again != nil {
+			t.Errorf("expected no new metadata, got %s", again)
+		}
+	})
+
+	t.Run("format version", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := applyIcebergTableDefinition([]byte(`{"format-version": 1}`), metadataLocation, icebergTableDefinition{}, now)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestReadIcebergTableDefinition(t *testing.T) {
+	t.Parallel()
+
+	const metadata = `{
+  "format-version": 2,
+  "current-schema-id": 1,
+  "schemas": [
+    {"type": "struct", "schema-id": 0, "fields": [
+      {"id": 1, "name": "id", "required": true, "type": "int"}
+    ]},
+    {"type": "struct", "schema-id": 1, "fields": [
+      {"id": 1, "name": "id", "required": true, "type": "long"},
+      {"id": 2, "name": "location", "required": false, "type": {"type": "struct", "fields": [
+        {"id": 4, "name": "lat", "required": false, "type": "double"}
+      ]}},
+      {"id": 3, "name": "created_at", "required": false, "type": "timestamptz"}
+    ]}
+  ],
+  "default-spec-id": 1,
+  "partition-specs": [
+    {"spec-id": 0, "fields": []},
+    {"spec-id": 1, "fields": [
+      {"field-id": 1000, "name": "created_at_day", "source-id": 3, "transform": "day"},
+      {"field-id": 1001, "name": "id_shard", "source-id": 1, "transform": "bucket[16]"}
+    ]}
+  ],
+  "default-sort-order-id": 0,
+  "sort-orders": [
+    {"order-id": 0, "fields": []},
+    {"order-id": 1, "fields": [
+      {"direction": "desc", "null-order": "nulls-last", "source-id": 3, "transform": "identity"}
+    ]}
+  ]
+}`
+
+	got, err := readIcebergTableDefinition([]byte(metadata))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Nested columns are not part of the definition.
+	want := icebergTableDefinition{
+		fields: []icebergFieldDefinition{
+			{name: "id", required: true, typ: "long"},
+			{name: "created_at", typ: "timestamptz"},
+		},
+		partitionFields: []icebergPartitionFieldDefinition{
+			{name: "created_at_day", sourceName: "created_at", transform: "day"},
+			{name: "id_shard", sourceName: "id", transform: "bucket[16]"},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(icebergTableDefinition{}, icebergFieldDefinition{}, icebergPartitionFieldDefinition{}, icebergSortFieldDefinition{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// A configured partition field without a name is equivalent to one with the default name.
+	if !icebergPartitionFieldsEqual([]icebergPartitionFieldDefinition{
+		{sourceName: "created_at", transform: "DAY"},
+		{name: "id_shard", sourceName: "id", transform: "bucket[16]"},
+	}, got.partitionFields) {
+		t.Error("expected equivalent partition specs")
+	}
+
+	// A changed partition spec or sort order is drift.
+	if icebergPartitionFieldsEqual([]icebergPartitionFieldDefinition{
+		{sourceName: "created_at", transform: "day"},
+	}, got.partitionFields) {
+		t.Error("expected different partition specs")
+	}
+	if icebergSortFieldsEqual([]icebergSortFieldDefinition{
+		{direction: "asc", nullOrder: "nulls-first", sourceName: "created_at", transform: "identity"},
+	}, got.sortFields) {
+		t.Error("expected different sort orders")
+	}
+
+	if _, err := readIcebergTableDefinition([]byte(`{"current-schema-id": 1, "schemas": []}`)); err == nil {
+		t.Error("expected error for missing current schema")
+	}
+}
+
+func TestNextIcebergMetadataLocation(t *testing.T) {
+	t.Parallel()
+
+	got, err := nextIcebergMetadataLocation("s3://example--table-s3/metadata/00007-4c5ba7fa-e5a2-4a51-b4a1-2f5fb10c5a2e.metadata.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if re := regexache.MustCompile(`^s3://example--table-s3/metadata/00008-[0-9a-f-]{36}\.metadata\.json$`); !re.MatchString(got) {
+		t.Errorf("unexpected location: %s", got)
+	}
+}
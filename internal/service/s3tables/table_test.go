@@ -577,7 +577,93 @@ func TestAccS3TablesTable_metadata(t *testing.T) {
 				ImportStateIdFunc:                    testAccTableImportStateIdFunc(resourceName),
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: names.AttrARN,
-				ImportStateVerifyIgnore:              []string{"metadata"},
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTable_metadataEvolution(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var table s3tables.GetTableOutput
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	namespace := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_metadataPartitioned(rName, namespace, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.partition_spec.0.field.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.partition_spec.0.field.0.source_name", names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.partition_spec.0.field.0.transform", "day"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.sort_order.0.field.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.sort_order.0.field.0.direction", "asc"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.sort_order.0.field.0.null_order", "nulls-first"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.sort_order.0.field.0.transform", "identity"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_location"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTableImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+			{
+				Config: testAccTableConfig_metadataEvolved(rName, namespace, bucketName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.schema.0.field.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.schema.0.field.0.type", "long"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.schema.0.field.3.name", names.AttrEmail),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.partition_spec.0.field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.iceberg.0.partition_spec.0.field.1.name", "id_shard"),
+				),
+			},
+			{
+				Config:      testAccTableConfig_metadata(rName, namespace, bucketName),
+				ExpectError: regexache.MustCompile(`Incompatible Iceberg Schema Change`),
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTable_metadataInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	namespace := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableConfig_metadataInvalid(rName, namespace, bucketName),
+				ExpectError: regexache.MustCompile(`partition transform "hour" cannot be applied to schema field "id" of type int`),
 			},
 		},
 	})
@@ -841,3 +927,149 @@ resource "aws_s3tables_table_bucket" "test" {
 }
 `, rName, namespace, bucketName)
 }
+
+func testAccTableConfig_metadataPartitioned(rName, namespace, bucketName string) string {
+	return acctest.ConfigCompose(testAccTableConfig_metadataBase(namespace, bucketName), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+
+  metadata {
+    iceberg {
+      schema {
+        field {
+          name     = "id"
+          type     = "int"
+          required = true
+        }
+        field {
+          name = "name"
+          type = "string"
+        }
+        field {
+          name     = "created_at"
+          type     = "timestamp"
+          required = true
+        }
+      }
+
+      partition_spec {
+        field {
+          source_name = "created_at"
+          transform   = "day"
+        }
+      }
+
+      sort_order {
+        field {
+          source_name = "name"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTableConfig_metadataEvolved(rName, namespace, bucketName string) string {
+	return acctest.ConfigCompose(testAccTableConfig_metadataBase(namespace, bucketName), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+
+  metadata {
+    iceberg {
+      schema {
+        field {
+          name     = "id"
+          type     = "long"
+          required = true
+        }
+        field {
+          name = "name"
+          type = "string"
+        }
+        field {
+          name = "created_at"
+          type = "timestamp"
+        }
+        field {
+          name = "email"
+          type = "string"
+        }
+      }
+
+      partition_spec {
+        field {
+          source_name = "created_at"
+          transform   = "day"
+        }
+        field {
+          name        = "id_shard"
+          source_name = "id"
+          transform   = "bucket[16]"
+        }
+      }
+
+      sort_order {
+        field {
+          source_name = "name"
+          direction   = "desc"
+          null_order  = "nulls-last"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTableConfig_metadataInvalid(rName, namespace, bucketName string) string {
+	return acctest.ConfigCompose(testAccTableConfig_metadataBase(namespace, bucketName), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+
+  metadata {
+    iceberg {
+      schema {
+        field {
+          name = "id"
+          type = "int"
+        }
+      }
+
+      partition_spec {
+        field {
+          source_name = "id"
+          transform   = "hour"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTableConfig_metadataBase(namespace, bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3tables_namespace" "test" {
+  namespace        = %[1]q
+  table_bucket_arn = aws_s3tables_table_bucket.test.arn
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[2]q
+}
+`, namespace, bucketName)
+}
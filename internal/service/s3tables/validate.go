@@ -4,8 +4,12 @@
 package s3tables
 
 import (
+	"context"
+
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var (
@@ -15,3 +19,48 @@ var (
 	stringMustStartWithLetterOrNumber = stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z]`), "must start with a letter or number")
 	stringMustEndWithLetterOrNumber   = stringvalidator.RegexMatches(regexache.MustCompile(`[0-9a-z]$`), "must end with a letter or number")
 )
+
+var (
+	icebergTypeValidator = parseValidator{
+		description: "value must be an Iceberg primitive type",
+		parse: func(s string) error {
+			_, err := parseIcebergType(s)
+			return err
+		},
+	}
+	icebergTransformValidator = parseValidator{
+		description: "value must be an Iceberg transform",
+		parse: func(s string) error {
+			_, err := parseIcebergTransform(s)
+			return err
+		},
+	}
+)
+
+// parseValidator validates that a string value can be parsed by the specified function.
+type parseValidator struct {
+	description string
+	parse       func(string) error
+}
+
+func (v parseValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v parseValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v parseValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.parse(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx)+": "+err.Error(),
+			request.ConfigValue.ValueString(),
+		))
+	}
+}
@@ -0,0 +1,400 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_sqs_queue_policy_statement", name="Queue Policy Statement")
+func newQueuePolicyStatementResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &queuePolicyStatementResource{}
+
+	r.SetDefaultCreateTimeout(3 * time.Minute)
+	r.SetDefaultUpdateTimeout(3 * time.Minute)
+	r.SetDefaultDeleteTimeout(3 * time.Minute)
+
+	return r, nil
+}
+
+type queuePolicyStatementResource struct {
+	framework.ResourceWithModel[queuePolicyStatementResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *queuePolicyStatementResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"queue_url": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *queuePolicyStatementResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data queuePolicyStatementResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Policy.IsNull() || data.Policy.IsUnknown() {
+		return
+	}
+
+	sid, err := tfiam.PolicySingleStatementSid(data.Policy.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrPolicy), "Invalid policy", err.Error())
+
+		return
+	}
+
+	if !data.SID.IsNull() && !data.SID.IsUnknown() && data.SID.ValueString() != sid {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrPolicy), "Invalid policy", fmt.Sprintf("policy statement Sid (%s) must match sid (%s)", sid, data.SID.ValueString()))
+	}
+}
+
+func (r *queuePolicyStatementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data queuePolicyStatementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SQSClient(ctx)
+
+	url, sid := data.QueueURL.ValueString(), data.SID.ValueString()
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	err := updateQueuePolicyStatement(ctx, conn, url, sid, func(policy string) (string, error) {
+		return tfiam.PolicyAddStatement(policy, data.Policy.ValueString())
+	}, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SQS Queue (%s) policy statement (%s)", url, sid), err.Error())
+
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{url, sid}, queuePolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID SQS Queue Policy Statement", err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
+	if _, err := waitQueuePolicyStatementPropagated(ctx, conn, url, sid, data.Policy.ValueString(), timeout); err != nil {
+		response.Diagnostics.Append(response.State.Set(ctx, data)...) // Set state so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SQS Queue (%s) policy statement (%s) create", url, sid), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *queuePolicyStatementResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data queuePolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().SQSClient(ctx)
+
+	output, err := findQueuePolicyStatementByTwoPartKey(ctx, conn, data.QueueURL.ValueString(), data.SID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SQS Queue Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	policy, err := verify.PolicyToSet(data.Policy.ValueString(), output)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SQS Queue Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Policy = fwtypes.IAMPolicyValue(policy)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *queuePolicyStatementResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new queuePolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SQSClient(ctx)
+
+	url, sid := new.QueueURL.ValueString(), new.SID.ValueString()
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+	err := updateQueuePolicyStatement(ctx, conn, url, sid, func(policy string) (string, error) {
+		return tfiam.PolicyPutStatement(policy, new.Policy.ValueString())
+	}, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SQS Queue Policy Statement (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitQueuePolicyStatementPropagated(ctx, conn, url, sid, new.Policy.ValueString(), timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SQS Queue Policy Statement (%s) update", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *queuePolicyStatementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data queuePolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SQSClient(ctx)
+
+	url, sid := data.QueueURL.ValueString(), data.SID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+	err := updateQueuePolicyStatement(ctx, conn, url, sid, func(policy string) (string, error) {
+		return tfiam.PolicyRemoveStatementBySid(policy, sid)
+	}, timeout)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SQS Queue Policy Statement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitQueuePolicyStatementDeleted(ctx, conn, url, sid, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SQS Queue Policy Statement (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// updateQueuePolicyStatement performs a read-modify-write of a queue's access policy.
+// Updates to the same queue are serialized within the provider.
+func updateQueuePolicyStatement(ctx context.Context, conn *sqs.Client, url, sid string, f func(string) (string, error), timeout time.Duration) error {
+	conns.GlobalMutexKV.Lock(url)
+	defer conns.GlobalMutexKV.Unlock(url)
+
+	var policy string
+	output, err := findQueueAttributeByTwoPartKey(ctx, conn, url, awstypes.QueueAttributeNamePolicy)
+
+	switch {
+	case err == nil:
+		policy = aws.ToString(output)
+	case errors.Is(err, tfresource.ErrEmptyResult):
+		// No access policy.
+	default:
+		return err
+	}
+
+	newPolicy, err := f(policy)
+
+	if err != nil {
+		return err
+	}
+
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: map[string]string{
+			string(awstypes.QueueAttributeNamePolicy): newPolicy,
+		},
+		QueueUrl: aws.String(url),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, timeout/2, func() (any, error) {
+		return conn.SetQueueAttributes(ctx, input)
+	}, errCodeInvalidAttributeValue, "Invalid value for the parameter Policy")
+
+	if tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
+		return &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	return err
+}
+
+func findQueuePolicyStatementByTwoPartKey(ctx context.Context, conn *sqs.Client, url, sid string) (string, error) {
+	output, err := findQueueAttributeByTwoPartKey(ctx, conn, url, awstypes.QueueAttributeNamePolicy)
+
+	if err != nil {
+		return "", err
+	}
+
+	return tfiam.PolicyFindStatementBySid(aws.ToString(output), sid)
+}
+
+const (
+	queuePolicyStatementStateEqual    = "equal"
+	queuePolicyStatementStateNotEqual = "notequal"
+)
+
+func statusQueuePolicyStatement(ctx context.Context, conn *sqs.Client, url, sid, expected string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findQueuePolicyStatementByTwoPartKey(ctx, conn, url, sid)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if expected != "" && !verify.PolicyStringsEquivalent(output, expected) {
+			return output, queuePolicyStatementStateNotEqual, nil
+		}
+
+		return output, queuePolicyStatementStateEqual, nil
+	}
+}
+
+func waitQueuePolicyStatementPropagated(ctx context.Context, conn *sqs.Client, url, sid, expected string, timeout time.Duration) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{queuePolicyStatementStateNotEqual},
+		Target:                    []string{queuePolicyStatementStateEqual},
+		Refresh:                   statusQueuePolicyStatement(ctx, conn, url, sid, expected),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 6,               // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
+		MinTimeout:                5 * time.Second, // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
+		NotFoundChecks:            10,              // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
+
+func waitQueuePolicyStatementDeleted(ctx context.Context, conn *sqs.Client, url, sid string, timeout time.Duration) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{queuePolicyStatementStateEqual},
+		Target:                    []string{},
+		Refresh:                   statusQueuePolicyStatement(ctx, conn, url, sid, ""),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 6,               // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
+		MinTimeout:                5 * time.Second, // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
+
+type queuePolicyStatementResourceModel struct {
+	framework.WithRegionModel
+	ID       types.String      `tfsdk:"id"`
+	Policy   fwtypes.IAMPolicy `tfsdk:"policy"`
+	QueueURL types.String      `tfsdk:"queue_url"`
+	SID      types.String      `tfsdk:"sid"`
+	Timeouts timeouts.Value    `tfsdk:"timeouts"`
+}
+
+const (
+	queuePolicyStatementResourceIDPartCount = 2
+)
+
+func (m *queuePolicyStatementResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), queuePolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.QueueURL = types.StringValue(parts[0])
+	m.SID = types.StringValue(parts[1])
+
+	return nil
+}
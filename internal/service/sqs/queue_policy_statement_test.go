@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSQSQueuePolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test1"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					testAccCheckQueuePolicyStatementExists(ctx, "aws_sqs_queue_policy_statement.test2"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", queueResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "sid", "AllowSNS"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsqs.ResourceQueuePolicyStatement, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccQueuePolicyStatementConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					testAccCheckQueuePolicyStatementExists(ctx, "aws_sqs_queue_policy_statement.test2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_sidMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueuePolicyStatementConfig_sidMismatch(rName),
+				ExpectError: regexache.MustCompile(`policy statement Sid \(Other\) must match sid \(AllowSNS\)`),
+			},
+		},
+	})
+}
+
+func testAccCheckQueuePolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sqs_queue_policy_statement" {
+				continue
+			}
+
+			_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["queue_url"], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SQS Queue Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckQueuePolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["queue_url"], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+func testAccQueuePolicyStatementConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name = %[1]q
+
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+  })
+}
+`, rName)
+}
+
+func testAccQueuePolicyStatementConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccQueuePolicyStatementConfig_base(rName), `
+data "aws_iam_policy_document" "test1" {
+  statement {
+    sid       = "AllowSNS"
+    actions   = ["sqs:SendMessage"]
+    resources = [aws_sqs_queue.test.arn]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sns.${data.aws_partition.current.dns_suffix}"]
+    }
+
+    condition {
+      test     = "ArnEquals"
+      variable = "aws:SourceArn"
+      values   = [aws_sns_topic.test.arn]
+    }
+  }
+}
+
+resource "aws_sqs_queue_policy_statement" "test1" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "AllowSNS"
+  policy    = data.aws_iam_policy_document.test1.json
+}
+
+data "aws_iam_policy_document" "test2" {
+  statement {
+    sid       = "AllowEventBridge"
+    actions   = ["sqs:SendMessage"]
+    resources = [aws_sqs_queue.test.arn]
+
+    principals {
+      type        = "Service"
+      identifiers = ["events.${data.aws_partition.current.dns_suffix}"]
+    }
+
+    condition {
+      test     = "ArnEquals"
+      variable = "aws:SourceArn"
+      values   = [aws_cloudwatch_event_rule.test.arn]
+    }
+  }
+}
+
+resource "aws_sqs_queue_policy_statement" "test2" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "AllowEventBridge"
+  policy    = data.aws_iam_policy_document.test2.json
+}
+`)
+}
+
+func testAccQueuePolicyStatementConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccQueuePolicyStatementConfig_base(rName), `
+data "aws_iam_policy_document" "test1" {
+  statement {
+    sid       = "AllowSNS"
+    actions   = ["sqs:SendMessage", "sqs:GetQueueAttributes"]
+    resources = [aws_sqs_queue.test.arn]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sns.${data.aws_partition.current.dns_suffix}"]
+    }
+
+    condition {
+      test     = "ArnEquals"
+      variable = "aws:SourceArn"
+      values   = [aws_sns_topic.test.arn]
+    }
+  }
+}
+
+resource "aws_sqs_queue_policy_statement" "test1" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "AllowSNS"
+  policy    = data.aws_iam_policy_document.test1.json
+}
+
+data "aws_iam_policy_document" "test2" {
+  statement {
+    sid       = "AllowEventBridge"
+    actions   = ["sqs:SendMessage"]
+    resources = [aws_sqs_queue.test.arn]
+
+    principals {
+      type        = "Service"
+      identifiers = ["events.${data.aws_partition.current.dns_suffix}"]
+    }
+
+    condition {
+      test     = "ArnEquals"
+      variable = "aws:SourceArn"
+      values   = [aws_cloudwatch_event_rule.test.arn]
+    }
+  }
+}
+
+resource "aws_sqs_queue_policy_statement" "test2" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "AllowEventBridge"
+  policy    = data.aws_iam_policy_document.test2.json
+}
+`)
+}
+
+func testAccQueuePolicyStatementConfig_sidMismatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue_policy_statement" "test" {
+  queue_url = "https://sqs.us-west-2.amazonaws.com/123456789012/%[1]s"
+  sid       = "AllowSNS"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "Other"
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "sqs:SendMessage"
+      Resource  = "*"
+    }]
+  })
+}
+`, rName)
+}
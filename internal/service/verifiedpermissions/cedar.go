@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfcedar "github.com/hashicorp/terraform-provider-aws/internal/cedar"
+)
+
+// validateCedarStatement checks the syntax of a Cedar policy or policy template statement.
+func validateCedarStatement(statement types.String, template bool, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if statement.IsNull() || statement.IsUnknown() {
+		return diags
+	}
+
+	parse := tfcedar.ParsePolicies
+	if template {
+		parse = tfcedar.ParsePolicyTemplate
+	}
+
+	policies, err := parse(statement.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(path, "Invalid Cedar statement", err.Error())
+
+		return diags
+	}
+
+	if n := len(policies); n != 1 {
+		diags.AddAttributeError(path, "Invalid Cedar statement", fmt.Sprintf("statement must contain exactly one policy, got %d", n))
+	}
+
+	return diags
+}
+
+// validateCedarStatementAgainstSchema checks a Cedar policy or policy template statement against a Cedar schema in JSON format,
+// typically the definition of an aws_verifiedpermissions_schema resource in the same configuration.
+func validateCedarStatementAgainstSchema(statement types.String, cedarSchema jsontypes.Normalized, template bool, statementPath, schemaPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if statement.IsNull() || statement.IsUnknown() || cedarSchema.IsNull() || cedarSchema.IsUnknown() {
+		return diags
+	}
+
+	schema, err := tfcedar.ParseSchema(cedarSchema.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(schemaPath, "Invalid Cedar schema", err.Error())
+
+		return diags
+	}
+
+	parse := tfcedar.ParsePolicies
+	if template {
+		parse = tfcedar.ParsePolicyTemplate
+	}
+
+	policies, err := parse(statement.ValueString())
+
+	if err != nil {
+		// Reported by validateCedarStatement.
+		return diags
+	}
+
+	if err := schema.ValidatePolicies(policies); err != nil {
+		diags.AddAttributeError(statementPath, "Cedar statement does not match schema", err.Error())
+	}
+
+	return diags
+}
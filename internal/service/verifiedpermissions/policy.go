@@ -10,8 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	cedar "github.com/cedar-policy/cedar-go/x/exp/parser"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -66,6 +68,10 @@ func (r *policyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"validation_schema": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"definition": schema.ListNestedBlock{
@@ -503,6 +509,23 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *policyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config policyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statement, diags := config.staticStatement(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCedarStatement(statement, false, staticStatementPath)...)
+	resp.Diagnostics.Append(validateCedarStatementAgainstSchema(statement, config.ValidationSchema, false, staticStatementPath, path.Root("validation_schema"))...)
+}
+
 func (r *policyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
 		var plan, state policyResourceModel
@@ -561,11 +584,35 @@ func findPolicyByID(ctx context.Context, conn *verifiedpermissions.Client, id, p
 
 type policyResourceModel struct {
 	framework.WithRegionModel
-	CreatedDate   timetypes.RFC3339                                 `tfsdk:"created_date"`
-	Definition    fwtypes.ListNestedObjectValueOf[policyDefinition] `tfsdk:"definition"`
-	ID            types.String                                      `tfsdk:"id"`
-	PolicyID      types.String                                      `tfsdk:"policy_id"`
-	PolicyStoreID types.String                                      `tfsdk:"policy_store_id"`
+	CreatedDate      timetypes.RFC3339                                 `tfsdk:"created_date"`
+	Definition       fwtypes.ListNestedObjectValueOf[policyDefinition] `tfsdk:"definition"`
+	ID               types.String                                      `tfsdk:"id"`
+	PolicyID         types.String                                      `tfsdk:"policy_id"`
+	PolicyStoreID    types.String                                      `tfsdk:"policy_store_id"`
+	ValidationSchema jsontypes.Normalized                              `tfsdk:"validation_schema"`
+}
+
+var (
+	staticStatementPath = path.Root("definition").AtListIndex(0).AtName("static").AtListIndex(0).AtName("statement")
+)
+
+// staticStatement returns the Cedar statement of a static policy definition, or a null value for a template-linked policy.
+func (m policyResourceModel) staticStatement(ctx context.Context) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	definition, d := m.Definition.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || definition == nil {
+		return types.StringNull(), diags
+	}
+
+	static, d := definition.Static.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || static == nil {
+		return types.StringNull(), diags
+	}
+
+	return static.Statement, diags
 }
 
 type policyDefinition struct {
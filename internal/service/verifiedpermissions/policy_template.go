@@ -11,7 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -74,12 +76,27 @@ func (r *policyTemplateResource) Schema(ctx context.Context, request resource.Sc
 			"statement": schema.StringAttribute{
 				Required: true,
 			},
+			"validation_schema": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
 		},
 	}
 
 	response.Schema = s
 }
 
+func (r *policyTemplateResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data policyTemplateResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(validateCedarStatement(data.Statement, true, path.Root("statement"))...)
+	response.Diagnostics.Append(validateCedarStatementAgainstSchema(data.Statement, data.ValidationSchema, true, path.Root("statement"), path.Root("validation_schema"))...)
+}
+
 func (r *policyTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	conn := r.Meta().VerifiedPermissionsClient(ctx)
 	var plan policyTemplateResourceModel
@@ -240,12 +257,13 @@ func (r *policyTemplateResource) Delete(ctx context.Context, request resource.De
 
 type policyTemplateResourceModel struct {
 	framework.WithRegionModel
-	CreatedDate      timetypes.RFC3339 `tfsdk:"created_date"`
-	Description      types.String      `tfsdk:"description"`
-	ID               types.String      `tfsdk:"id"`
-	PolicyStoreID    types.String      `tfsdk:"policy_store_id"`
-	PolicyTemplateID types.String      `tfsdk:"policy_template_id"`
-	Statement        types.String      `tfsdk:"statement"`
+	CreatedDate      timetypes.RFC3339    `tfsdk:"created_date"`
+	Description      types.String         `tfsdk:"description"`
+	ID               types.String         `tfsdk:"id"`
+	PolicyStoreID    types.String         `tfsdk:"policy_store_id"`
+	PolicyTemplateID types.String         `tfsdk:"policy_template_id"`
+	Statement        types.String         `tfsdk:"statement"`
+	ValidationSchema jsontypes.Normalized `tfsdk:"validation_schema"`
 }
 
 func findPolicyTemplateByID(ctx context.Context, conn *verifiedpermissions.Client, policyStoreId, id string) (*verifiedpermissions.GetPolicyTemplateOutput, error) {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -189,6 +190,40 @@ func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedPermissionsPolicy_validationSchema(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_validationSchema(rName, `permit (principal, action == PhotoApp::Action::\"deletePhoto\", resource);`),
+				ExpectError: regexache.MustCompile(`Cedar statement does not match schema`),
+			},
+			{
+				Config: testAccPolicyConfig_validationSchema(rName, `permit (principal, action == PhotoApp::Action::\"viewPhoto\", resource);`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttrPair(resourceName, "validation_schema", "aws_verifiedpermissions_schema.test", "definition.0.value"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
@@ -318,3 +353,49 @@ resource "aws_verifiedpermissions_policy" "test" {
 }
 `, rName))
 }
+
+func testAccPolicyConfig_validationSchema(rName, policyStatement string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+
+  validation_settings {
+    mode = "STRICT"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.policy_store_id
+
+  definition {
+    value = jsonencode({
+      PhotoApp = {
+        entityTypes = {
+          User  = {}
+          Photo = {}
+        }
+        actions = {
+          viewPhoto = {
+            appliesTo = {
+              principalTypes = ["User"]
+              resourceTypes  = ["Photo"]
+            }
+          }
+        }
+      }
+    })
+  }
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id   = aws_verifiedpermissions_schema.test.policy_store_id
+  validation_schema = aws_verifiedpermissions_schema.test.definition[0].value
+
+  definition {
+    static {
+      statement = "%[2]s"
+    }
+  }
+}
+`, rName, policyStatement)
+}
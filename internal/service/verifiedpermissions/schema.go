@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfcedar "github.com/hashicorp/terraform-provider-aws/internal/cedar"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
@@ -80,6 +82,28 @@ func (r *schemaResource) Schema(ctx context.Context, request resource.SchemaRequ
 	}
 }
 
+func (r *schemaResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data schemaResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	definition, diags := data.Definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || definition == nil {
+		return
+	}
+
+	if definition.Value.IsNull() || definition.Value.IsUnknown() {
+		return
+	}
+
+	if _, err := tfcedar.ParseSchema(definition.Value.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("definition").AtListIndex(0).AtName(names.AttrValue), "Invalid Cedar schema", err.Error())
+	}
+}
+
 func (r *schemaResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	schemaV0 := schemaSchemaV0()
 
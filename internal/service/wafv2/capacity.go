@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+)
+
+// Web ACL capacity unit (WCU) costs.
+// See https://docs.aws.amazon.com/waf/latest/developerguide/aws-waf-capacity-units.html
+// and the per-statement "WCUs" notes under
+// https://docs.aws.amazon.com/waf/latest/developerguide/waf-rule-statements-list.html.
+const (
+	capacityASNMatch                 = 1
+	capacityByteMatchContains        = 10
+	capacityByteMatchExact           = 2
+	capacityGeoMatch                 = 1
+	capacityIPSetReference           = 1
+	capacityIPSetForwardedIPAny      = 4
+	capacityLabelMatch               = 1
+	capacityRateBased                = 2
+	capacityRateBasedCustomKey       = 30
+	capacityRegexMatch               = 3
+	capacityRegexPatternSetReference = 25
+	capacitySizeConstraint           = 1
+	capacitySQLiMatch                = 20
+	capacitySQLiMatchHighSensitivity = 30
+	capacityTextTransformation       = 10
+	capacityAllQueryArguments        = 10
+	capacityXSSMatch                 = 40
+
+	// webACLDefaultCapacityLimit is the number of WCUs included in the base web ACL price.
+	webACLDefaultCapacityLimit = 1500
+)
+
+// managedRuleGroupCapacities holds the published capacities of the AWS Managed Rules rule groups,
+// keyed by "<vendor>/<name>".
+// See https://docs.aws.amazon.com/waf/latest/developerguide/aws-managed-rule-groups-list.html.
+var managedRuleGroupCapacities = map[string]int64{
+	"AWS/AWSManagedRulesACFPRuleSet":            50,
+	"AWS/AWSManagedRulesATPRuleSet":             50,
+	"AWS/AWSManagedRulesAdminProtectionRuleSet": 100,
+	"AWS/AWSManagedRulesAmazonIpReputationList": 25,
+	"AWS/AWSManagedRulesAnonymousIpList":        50,
+	"AWS/AWSManagedRulesAntiDDoSRuleSet":        50,
+	"AWS/AWSManagedRulesBotControlRuleSet":      50,
+	"AWS/AWSManagedRulesCommonRuleSet":          700,
+	"AWS/AWSManagedRulesKnownBadInputsRuleSet":  200,
+	"AWS/AWSManagedRulesLinuxRuleSet":           200,
+	"AWS/AWSManagedRulesPHPRuleSet":             100,
+	"AWS/AWSManagedRulesSQLiRuleSet":            200,
+	"AWS/AWSManagedRulesUnixRuleSet":            100,
+	"AWS/AWSManagedRulesWindowsRuleSet":         200,
+	"AWS/AWSManagedRulesWordPressRuleSet":       100,
+}
+
+// managedRuleGroupCapacityKey returns the key used to look up the capacity of a managed rule group.
+func managedRuleGroupCapacityKey(vendorName, name string) string {
+	return vendorName + "/" + name
+}
+
+// capacityCalculator estimates the WCUs consumed by web ACL or rule group rules
+// and validates the nesting of their statements.
+type capacityCalculator struct {
+	// ruleGroupCapacities holds the capacities of rule groups that can't be determined locally,
+	// keyed by rule group ARN (rule group reference statements) or "<vendor>/<name>" (managed rule group statements).
+	ruleGroupCapacities map[string]int64
+}
+
+func newCapacityCalculator(ruleGroupCapacities map[string]int64) *capacityCalculator {
+	return &capacityCalculator{
+		ruleGroupCapacities: ruleGroupCapacities,
+	}
+}
+
+// ruleCapacity is the estimated capacity of a single rule.
+type ruleCapacity struct {
+	name     string
+	priority int32
+	capacity int64
+}
+
+// rulesCapacity returns the estimated capacity of each rule.
+// All validation errors are returned together.
+func (c *capacityCalculator) rulesCapacity(rules []awstypes.Rule) ([]ruleCapacity, error) {
+	var errs []error
+	results := make([]ruleCapacity, 0, len(rules))
+	ruleNames := make(map[string]struct{})
+	priorities := make(map[int32]string)
+
+	for i, rule := range rules {
+		name := aws.ToString(rule.Name)
+		path := fmt.Sprintf("rule[%d]", i)
+		if name != "" {
+			path = fmt.Sprintf("rule %q", name)
+		}
+
+		if name == "" {
+			errs = append(errs, fmt.Errorf("%s: Name is required", path))
+		} else if _, ok := ruleNames[name]; ok {
+			errs = append(errs, fmt.Errorf("%s: duplicate rule name", path))
+		} else {
+			ruleNames[name] = struct{}{}
+		}
+
+		if other, ok := priorities[rule.Priority]; ok {
+			errs = append(errs, fmt.Errorf("%s: Priority %d is already used by rule %q", path, rule.Priority, other))
+		} else {
+			priorities[rule.Priority] = name
+		}
+
+		if rule.Statement == nil {
+			errs = append(errs, fmt.Errorf("%s: Statement is required", path))
+			continue
+		}
+
+		if isRuleGroupStatement(rule.Statement) {
+			if rule.Action != nil {
+				errs = append(errs, fmt.Errorf("%s: Action is not supported for rule group statements, use OverrideAction", path))
+			}
+		} else if rule.OverrideAction != nil {
+			errs = append(errs, fmt.Errorf("%s: OverrideAction is only supported for rule group statements", path))
+		}
+
+		capacity, err := c.statementCapacity(rule.Statement, path+": Statement", true)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		results = append(results, ruleCapacity{
+			name:     name,
+			priority: rule.Priority,
+			capacity: capacity,
+		})
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// statementCapacity returns the estimated capacity of a statement and all of its nested statements.
+// topLevel indicates whether the statement is a rule's root statement.
+func (c *capacityCalculator) statementCapacity(statement *awstypes.Statement, path string, topLevel bool) (int64, error) {
+	if statement == nil {
+		return 0, fmt.Errorf("%s: statement is required", path)
+	}
+
+	if n := statementTypeCount(statement); n != 1 {
+		return 0, fmt.Errorf("%s: exactly one statement type must be specified, got %d", path, n)
+	}
+
+	switch {
+	case statement.AndStatement != nil:
+		return c.logicalStatementCapacity(statement.AndStatement.Statements, path+".AndStatement")
+
+	case statement.OrStatement != nil:
+		return c.logicalStatementCapacity(statement.OrStatement.Statements, path+".OrStatement")
+
+	case statement.NotStatement != nil:
+		return c.statementCapacity(statement.NotStatement.Statement, path+".NotStatement.Statement", false)
+
+	case statement.AsnMatchStatement != nil:
+		return capacityASNMatch, nil
+
+	case statement.ByteMatchStatement != nil:
+		v := statement.ByteMatchStatement
+		path += ".ByteMatchStatement"
+
+		var base int64
+		switch v.PositionalConstraint {
+		case awstypes.PositionalConstraintExactly, awstypes.PositionalConstraintStartsWith, awstypes.PositionalConstraintEndsWith:
+			base = capacityByteMatchExact
+		case awstypes.PositionalConstraintContains, awstypes.PositionalConstraintContainsWord:
+			base = capacityByteMatchContains
+		default:
+			return 0, fmt.Errorf("%s: unsupported PositionalConstraint %q", path, v.PositionalConstraint)
+		}
+
+		return matchStatementCapacity(base, v.FieldToMatch, v.TextTransformations, path)
+
+	case statement.GeoMatchStatement != nil:
+		return capacityGeoMatch, nil
+
+	case statement.IPSetReferenceStatement != nil:
+		v := statement.IPSetReferenceStatement
+		capacity := int64(capacityIPSetReference)
+		if v.IPSetForwardedIPConfig != nil && v.IPSetForwardedIPConfig.Position == awstypes.ForwardedIPPositionAny {
+			capacity += capacityIPSetForwardedIPAny
+		}
+
+		return capacity, nil
+
+	case statement.LabelMatchStatement != nil:
+		return capacityLabelMatch, nil
+
+	case statement.ManagedRuleGroupStatement != nil:
+		v := statement.ManagedRuleGroupStatement
+		path += ".ManagedRuleGroupStatement"
+
+		if !topLevel {
+			return 0, fmt.Errorf("%s: managed rule group statements can't be nested", path)
+		}
+
+		key := managedRuleGroupCapacityKey(aws.ToString(v.VendorName), aws.ToString(v.Name))
+		capacity, ok := c.ruleGroupCapacities[key]
+		if !ok {
+			capacity, ok = managedRuleGroupCapacities[key]
+		}
+		if !ok {
+			return 0, fmt.Errorf("%s: capacity of managed rule group %q is unknown, specify it in rule_group_capacities", path, key)
+		}
+
+		if v.ScopeDownStatement != nil {
+			n, err := c.scopeDownStatementCapacity(v.ScopeDownStatement, path+".ScopeDownStatement")
+			if err != nil {
+				return 0, err
+			}
+			capacity += n
+		}
+
+		return capacity, nil
+
+	case statement.RateBasedStatement != nil:
+		v := statement.RateBasedStatement
+		path += ".RateBasedStatement"
+
+		if !topLevel {
+			return 0, fmt.Errorf("%s: rate-based statements can't be nested", path)
+		}
+
+		capacity := int64(capacityRateBased)
+		if v.AggregateKeyType == awstypes.RateBasedStatementAggregateKeyTypeCustomKeys {
+			if len(v.CustomKeys) == 0 {
+				return 0, fmt.Errorf("%s: CustomKeys is required when AggregateKeyType is %q", path, v.AggregateKeyType)
+			}
+			capacity += int64(len(v.CustomKeys)) * capacityRateBasedCustomKey
+		}
+
+		if v.ScopeDownStatement != nil {
+			n, err := c.scopeDownStatementCapacity(v.ScopeDownStatement, path+".ScopeDownStatement")
+			if err != nil {
+				return 0, err
+			}
+			capacity += n
+		}
+
+		return capacity, nil
+
+	case statement.RegexMatchStatement != nil:
+		v := statement.RegexMatchStatement
+
+		return matchStatementCapacity(capacityRegexMatch, v.FieldToMatch, v.TextTransformations, path+".RegexMatchStatement")
+
+	case statement.RegexPatternSetReferenceStatement != nil:
+		v := statement.RegexPatternSetReferenceStatement
+
+		return matchStatementCapacity(capacityRegexPatternSetReference, v.FieldToMatch, v.TextTransformations, path+".RegexPatternSetReferenceStatement")
+
+	case statement.RuleGroupReferenceStatement != nil:
+		v := statement.RuleGroupReferenceStatement
+		path += ".RuleGroupReferenceStatement"
+
+		if !topLevel {
+			return 0, fmt.Errorf("%s: rule group reference statements can't be nested", path)
+		}
+
+		arn := aws.ToString(v.ARN)
+		capacity, ok := c.ruleGroupCapacities[arn]
+		if !ok {
+			return 0, fmt.Errorf("%s: capacity of rule group %q is unknown, specify it in rule_group_capacities", path, arn)
+		}
+
+		return capacity, nil
+
+	case statement.SizeConstraintStatement != nil:
+		v := statement.SizeConstraintStatement
+
+		return matchStatementCapacity(capacitySizeConstraint, v.FieldToMatch, v.TextTransformations, path+".SizeConstraintStatement")
+
+	case statement.SqliMatchStatement != nil:
+		v := statement.SqliMatchStatement
+
+		base := int64(capacitySQLiMatch)
+		if v.SensitivityLevel == awstypes.SensitivityLevelHigh {
+			base = capacitySQLiMatchHighSensitivity
+		}
+
+		return matchStatementCapacity(base, v.FieldToMatch, v.TextTransformations, path+".SqliMatchStatement")
+
+	case statement.XssMatchStatement != nil:
+		v := statement.XssMatchStatement
+
+		return matchStatementCapacity(capacityXSSMatch, v.FieldToMatch, v.TextTransformations, path+".XssMatchStatement")
+	}
+
+	return 0, fmt.Errorf("%s: unsupported statement type", path)
+}
+
+// logicalStatementCapacity returns the capacity of an AND or OR statement, which is the sum of its nested statements.
+func (c *capacityCalculator) logicalStatementCapacity(statements []awstypes.Statement, path string) (int64, error) {
+	if len(statements) < 2 {
+		return 0, fmt.Errorf("%s: at least 2 nested statements are required, got %d", path, len(statements))
+	}
+
+	var errs []error
+	var capacity int64
+	for i := range statements {
+		n, err := c.statementCapacity(&statements[i], fmt.Sprintf("%s.Statements[%d]", path, i), false)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		capacity += n
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return 0, err
+	}
+
+	return capacity, nil
+}
+
+// scopeDownStatementCapacity returns the capacity of a rate-based or managed rule group scope-down statement.
+func (c *capacityCalculator) scopeDownStatementCapacity(statement *awstypes.Statement, path string) (int64, error) {
+	if isRuleGroupStatement(statement) || statement.RateBasedStatement != nil {
+		return 0, fmt.Errorf("%s: scope-down statements must be nestable statements", path)
+	}
+
+	return c.statementCapacity(statement, path, false)
+}
+
+// matchStatementCapacity returns the capacity of a statement that inspects a web request component.
+func matchStatementCapacity(base int64, fieldToMatch *awstypes.FieldToMatch, textTransformations []awstypes.TextTransformation, path string) (int64, error) {
+	if fieldToMatch == nil {
+		return 0, fmt.Errorf("%s: FieldToMatch is required", path)
+	}
+
+	capacity := base
+	switch {
+	case fieldToMatch.JsonBody != nil:
+		capacity *= 2
+	case fieldToMatch.AllQueryArguments != nil:
+		capacity += capacityAllQueryArguments
+	}
+
+	if len(textTransformations) == 0 {
+		return 0, fmt.Errorf("%s: at least 1 TextTransformation is required", path)
+	}
+
+	priorities := make(map[int32]struct{})
+	for _, v := range textTransformations {
+		if _, ok := priorities[v.Priority]; ok {
+			return 0, fmt.Errorf("%s: duplicate TextTransformation priority %d", path, v.Priority)
+		}
+		priorities[v.Priority] = struct{}{}
+
+		if v.Type != awstypes.TextTransformationTypeNone {
+			capacity += capacityTextTransformation
+		}
+	}
+
+	return capacity, nil
+}
+
+// isRuleGroupStatement returns whether the statement references a rule group.
+func isRuleGroupStatement(statement *awstypes.Statement) bool {
+	return statement.ManagedRuleGroupStatement != nil || statement.RuleGroupReferenceStatement != nil
+}
+
+func statementTypeCount(statement *awstypes.Statement) int {
+	n := 0
+	for _, v := range []bool{
+		statement.AndStatement != nil,
+		statement.AsnMatchStatement != nil,
+		statement.ByteMatchStatement != nil,
+		statement.GeoMatchStatement != nil,
+		statement.IPSetReferenceStatement != nil,
+		statement.LabelMatchStatement != nil,
+		statement.ManagedRuleGroupStatement != nil,
+		statement.NotStatement != nil,
+		statement.OrStatement != nil,
+		statement.RateBasedStatement != nil,
+		statement.RegexMatchStatement != nil,
+		statement.RegexPatternSetReferenceStatement != nil,
+		statement.RuleGroupReferenceStatement != nil,
+		statement.SizeConstraintStatement != nil,
+		statement.SqliMatchStatement != nil,
+		statement.XssMatchStatement != nil,
+	} {
+		if v {
+			n++
+		}
+	}
+
+	return n
+}
+
+// formatCapacityErrors flattens joined validation errors into one error per line.
+func formatCapacityErrors(err error) []string {
+	return strings.Split(err.Error(), "\n")
+}
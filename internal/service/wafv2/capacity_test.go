@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCapacityCalculator_rulesCapacity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rawRules            string
+		ruleGroupCapacities map[string]int64
+		want                []ruleCapacity
+		wantErr             string
+	}{
+		"no rules": {
+			rawRules: `[]`,
+			want:     []ruleCapacity{},
+		},
+		"geo match": {
+			rawRules: `[{"Name":"geo","Priority":1,"Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}}}]`,
+			want: []ruleCapacity{
+				{name: "geo", priority: 1, capacity: 1},
+			},
+		},
+		"byte match with text transformations": {
+			rawRules: `[{"Name":"byte","Priority":1,"Action":{"Block":{}},"Statement":{"ByteMatchStatement":{"FieldToMatch":{"UriPath":{}},"PositionalConstraint":"CONTAINS","SearchString":"admin","TextTransformations":[{"Priority":0,"Type":"NONE"},{"Priority":1,"Type":"LOWERCASE"},{"Priority":2,"Type":"URL_DECODE"}]}}}]`,
+			want: []ruleCapacity{
+				{name: "byte", priority: 1, capacity: 30},
+			},
+		},
+		"json body doubles base cost": {
+			rawRules: `[{"Name":"xss","Priority":1,"Action":{"Block":{}},"Statement":{"XssMatchStatement":{"FieldToMatch":{"JsonBody":{"MatchPattern":{"All":{}},"MatchScope":"ALL"}},"TextTransformations":[{"Priority":0,"Type":"NONE"}]}}}]`,
+			want: []ruleCapacity{
+				{name: "xss", priority: 1, capacity: 80},
+			},
+		},
+		"all query arguments": {
+			rawRules: `[{"Name":"sqli","Priority":1,"Action":{"Block":{}},"Statement":{"SqliMatchStatement":{"FieldToMatch":{"AllQueryArguments":{}},"SensitivityLevel":"HIGH","TextTransformations":[{"Priority":0,"Type":"URL_DECODE"}]}}}]`,
+			want: []ruleCapacity{
+				{name: "sqli", priority: 1, capacity: 50},
+			},
+		},
+		"nested logical statements": {
+			rawRules: `[{"Name":"nested","Priority":1,"Action":{"Count":{}},"Statement":{"AndStatement":{"Statements":[{"NotStatement":{"Statement":{"IPSetReferenceStatement":{"ARN":"arn:aws:wafv2:us-east-1:123456789012:regional/ipset/a/b","IPSetForwardedIPConfig":{"FallbackBehavior":"MATCH","HeaderName":"X-Forwarded-For","Position":"ANY"}}}}},{"OrStatement":{"Statements":[{"RegexPatternSetReferenceStatement":{"ARN":"arn:aws:wafv2:us-east-1:123456789012:regional/regexpatternset/a/b","FieldToMatch":{"UriPath":{}},"TextTransformations":[{"Priority":0,"Type":"NONE"}]}},{"LabelMatchStatement":{"Key":"a:b","Scope":"LABEL"}}]}}]}}}]`,
+			want: []ruleCapacity{
+				{name: "nested", priority: 1, capacity: 31},
+			},
+		},
+		"rate-based with custom keys and scope-down": {
+			rawRules: `[{"Name":"rate","Priority":1,"Action":{"Block":{}},"Statement":{"RateBasedStatement":{"AggregateKeyType":"CUSTOM_KEYS","Limit":100,"CustomKeys":[{"IP":{}},{"HTTPMethod":{}}],"ScopeDownStatement":{"GeoMatchStatement":{"CountryCodes":["US"]}}}}}]`,
+			want: []ruleCapacity{
+				{name: "rate", priority: 1, capacity: 63},
+			},
+		},
+		"managed and referenced rule groups": {
+			rawRules:            `[{"Name":"common","Priority":1,"OverrideAction":{"None":{}},"Statement":{"ManagedRuleGroupStatement":{"Name":"AWSManagedRulesCommonRuleSet","VendorName":"AWS"}}},{"Name":"custom","Priority":2,"OverrideAction":{"None":{}},"Statement":{"RuleGroupReferenceStatement":{"ARN":"arn:aws:wafv2:us-east-1:123456789012:regional/rulegroup/a/b"}}}]`,
+			ruleGroupCapacities: map[string]int64{"arn:aws:wafv2:us-east-1:123456789012:regional/rulegroup/a/b": 42},
+			want: []ruleCapacity{
+				{name: "common", priority: 1, capacity: 700},
+				{name: "custom", priority: 2, capacity: 42},
+			},
+		},
+		"unknown rule group": {
+			rawRules: `[{"Name":"custom","Priority":1,"OverrideAction":{"None":{}},"Statement":{"RuleGroupReferenceStatement":{"ARN":"arn:aws:wafv2:us-east-1:123456789012:regional/rulegroup/a/b"}}}]`,
+			wantErr:  "capacity of rule group",
+		},
+		"and statement with one nested statement": {
+			rawRules: `[{"Name":"and","Priority":1,"Action":{"Block":{}},"Statement":{"AndStatement":{"Statements":[{"GeoMatchStatement":{"CountryCodes":["US"]}}]}}}]`,
+			wantErr:  "at least 2 nested statements are required",
+		},
+		"nested rate-based statement": {
+			rawRules: `[{"Name":"not","Priority":1,"Action":{"Block":{}},"Statement":{"NotStatement":{"Statement":{"RateBasedStatement":{"AggregateKeyType":"IP","Limit":100}}}}}]`,
+			wantErr:  "rate-based statements can't be nested",
+		},
+		"nested managed rule group statement": {
+			rawRules: `[{"Name":"or","Priority":1,"Action":{"Block":{}},"Statement":{"OrStatement":{"Statements":[{"GeoMatchStatement":{"CountryCodes":["US"]}},{"ManagedRuleGroupStatement":{"Name":"AWSManagedRulesCommonRuleSet","VendorName":"AWS"}}]}}}]`,
+			wantErr:  "managed rule group statements can't be nested",
+		},
+		"multiple statement types": {
+			rawRules: `[{"Name":"multi","Priority":1,"Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]},"LabelMatchStatement":{"Key":"a:b","Scope":"LABEL"}}}]`,
+			wantErr:  "exactly one statement type must be specified",
+		},
+		"duplicate priority": {
+			rawRules: `[{"Name":"a","Priority":1,"Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}}},{"Name":"b","Priority":1,"Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}}}]`,
+			wantErr:  `Priority 1 is already used by rule "a"`,
+		},
+		"override action on non rule group statement": {
+			rawRules: `[{"Name":"geo","Priority":1,"OverrideAction":{"None":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}}}]`,
+			wantErr:  "OverrideAction is only supported for rule group statements",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := expandWebACLRulesJSON(testCase.rawRules)
+			if err != nil {
+				t.Fatalf("unexpected error expanding rules: %s", err)
+			}
+
+			got, err := newCapacityCalculator(testCase.ruleGroupCapacities).rulesCapacity(rules)
+
+			if testCase.wantErr != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.wantErr)
+				}
+				if !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %q", testCase.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(ruleCapacity{}), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_wafv2_web_acl_capacity", name="Web ACL Capacity")
+// @Region(overrideEnabled=false)
+func newWebACLCapacityDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &webACLCapacityDataSource{}, nil
+}
+
+type webACLCapacityDataSource struct {
+	framework.DataSourceWithModel[webACLCapacityDataSourceModel]
+}
+
+func (d *webACLCapacityDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"capacity": schema.Int64Attribute{
+				Computed: true,
+			},
+			"capacity_limit": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5000),
+				},
+			},
+			"rule_capacities": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ruleCapacityModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[ruleCapacityModel](ctx),
+				},
+			},
+			"rule_group_capacities": schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[types.Int64](ctx),
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"rule_json": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (d *webACLCapacityDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data webACLCapacityDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := expandWebACLRulesJSON(data.RuleJSON.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("rule_json"), "Invalid rule_json", err.Error())
+
+		return
+	}
+
+	ruleGroupCapacities := make(map[string]int64)
+	for k, v := range data.RuleGroupCapacities.Elements() {
+		ruleGroupCapacities[k] = v.(types.Int64).ValueInt64()
+	}
+
+	ruleCapacities, err := newCapacityCalculator(ruleGroupCapacities).rulesCapacity(rules)
+
+	if err != nil {
+		for _, v := range formatCapacityErrors(err) {
+			response.Diagnostics.AddAttributeError(path.Root("rule_json"), "Invalid WAFv2 rule", v)
+		}
+
+		return
+	}
+
+	var capacity int64
+	rulesData := make([]ruleCapacityModel, 0, len(ruleCapacities))
+	for _, v := range ruleCapacities {
+		capacity += v.capacity
+		rulesData = append(rulesData, ruleCapacityModel{
+			Capacity: types.Int64Value(v.capacity),
+			Name:     types.StringValue(v.name),
+			Priority: types.Int64Value(int64(v.priority)),
+		})
+	}
+
+	if data.CapacityLimit.IsNull() || data.CapacityLimit.IsUnknown() {
+		data.CapacityLimit = types.Int64Value(webACLDefaultCapacityLimit)
+	}
+
+	if limit := data.CapacityLimit.ValueInt64(); capacity > limit {
+		response.Diagnostics.AddWarning(
+			"WAFv2 capacity limit exceeded",
+			fmt.Sprintf("The estimated capacity of the rules (%d WCUs) exceeds the capacity limit (%d WCUs).", capacity, limit),
+		)
+	}
+
+	data.Capacity = types.Int64Value(capacity)
+	data.RuleCapacities = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, rulesData)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type webACLCapacityDataSourceModel struct {
+	Capacity            types.Int64                                        `tfsdk:"capacity"`
+	CapacityLimit       types.Int64                                        `tfsdk:"capacity_limit"`
+	RuleCapacities      fwtypes.ListNestedObjectValueOf[ruleCapacityModel] `tfsdk:"rule_capacities"`
+	RuleGroupCapacities fwtypes.MapValueOf[types.Int64]                    `tfsdk:"rule_group_capacities"`
+	RuleJSON            jsontypes.Normalized                               `tfsdk:"rule_json"`
+}
+
+type ruleCapacityModel struct {
+	Capacity types.Int64  `tfsdk:"capacity"`
+	Name     types.String `tfsdk:"name"`
+	Priority types.Int64  `tfsdk:"priority"`
+}
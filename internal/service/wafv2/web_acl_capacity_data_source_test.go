@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWAFV2WebACLCapacityDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_wafv2_web_acl_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLCapacityDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "capacity", "733"),
+					resource.TestCheckResourceAttr(dataSourceName, "capacity_limit", "1500"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule_capacities.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule_capacities.0.name", "common"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule_capacities.0.capacity", "700"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule_capacities.1.name", "admin-path"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule_capacities.1.capacity", "33"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLCapacityDataSource_invalidNesting(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebACLCapacityDataSourceConfig_invalidNesting,
+				ExpectError: regexache.MustCompile(`rate-based statements can't be nested`),
+			},
+		},
+	})
+}
+
+const testAccWebACLCapacityDataSourceConfig_basic = `
+data "aws_wafv2_web_acl_capacity" "test" {
+  rule_json = jsonencode([
+    {
+      Name     = "common"
+      Priority = 1
+      OverrideAction = {
+        None = {}
+      }
+      Statement = {
+        ManagedRuleGroupStatement = {
+          Name       = "AWSManagedRulesCommonRuleSet"
+          VendorName = "AWS"
+        }
+      }
+      VisibilityConfig = {
+        CloudwatchMetricsEnabled = false
+        MetricName               = "common"
+        SampledRequestsEnabled   = false
+      }
+    },
+    {
+      Name     = "admin-path"
+      Priority = 2
+      Action = {
+        Block = {}
+      }
+      Statement = {
+        AndStatement = {
+          Statements = [
+            {
+              ByteMatchStatement = {
+                FieldToMatch = {
+                  UriPath = {}
+                }
+                PositionalConstraint = "STARTS_WITH"
+                SearchString         = "/admin"
+                TextTransformations = [
+                  {
+                    Priority = 0
+                    Type     = "LOWERCASE"
+                  },
+                ]
+              }
+            },
+            {
+              NotStatement = {
+                Statement = {
+                  IPSetReferenceStatement = {
+                    ARN = "arn:aws:wafv2:us-west-2:123456789012:regional/ipset/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+                  }
+                }
+              }
+            },
+            {
+              SqliMatchStatement = {
+                FieldToMatch = {
+                  QueryString = {}
+                }
+                TextTransformations = [
+                  {
+                    Priority = 0
+                    Type     = "NONE"
+                  },
+                ]
+              }
+            },
+          ]
+        }
+      }
+      VisibilityConfig = {
+        CloudwatchMetricsEnabled = false
+        MetricName               = "admin-path"
+        SampledRequestsEnabled   = false
+      }
+    },
+  ])
+}
+`
+
+const testAccWebACLCapacityDataSourceConfig_invalidNesting = `
+data "aws_wafv2_web_acl_capacity" "test" {
+  rule_json = jsonencode([
+    {
+      Name     = "rate"
+      Priority = 1
+      Action = {
+        Block = {}
+      }
+      Statement = {
+        NotStatement = {
+          Statement = {
+            RateBasedStatement = {
+              AggregateKeyType = "IP"
+              Limit            = 100
+            }
+          }
+        }
+      }
+      VisibilityConfig = {
+        CloudwatchMetricsEnabled = false
+        MetricName               = "rate"
+        SampledRequestsEnabled   = false
+      }
+    },
+  ])
+}
+`
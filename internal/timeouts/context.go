@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+)
+
+// NewContext returns a Context enhanced with the provider-level default timeouts of the current resource.
+func NewContext(ctx context.Context, defaults Timeouts) context.Context {
+	return context.WithValue(ctx, timeoutsKey, defaults)
+}
+
+// FromContext returns the provider-level default timeouts kept in Context.
+func FromContext(ctx context.Context) (Timeouts, bool) {
+	v, ok := ctx.Value(timeoutsKey).(Timeouts)
+	return v, ok
+}
+
+type keyType int
+
+var timeoutsKey keyType
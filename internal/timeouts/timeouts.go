@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"time"
+)
+
+// Timeouts holds operation timeouts. A zero value means that no timeout is set for the operation.
+type Timeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// IsZero returns whether no timeout is set for any operation.
+func (t Timeouts) IsZero() bool {
+	return t == Timeouts{}
+}
+
+// withDefaults returns t with any unset operation timeouts taken from defaults.
+func (t Timeouts) withDefaults(defaults Timeouts) Timeouts {
+	if t.Create == 0 {
+		t.Create = defaults.Create
+	}
+	if t.Read == 0 {
+		t.Read = defaults.Read
+	}
+	if t.Update == 0 {
+		t.Update = defaults.Update
+	}
+	if t.Delete == 0 {
+		t.Delete = defaults.Delete
+	}
+
+	return t
+}
+
+// DefaultConfig contains provider-level default timeouts.
+type DefaultConfig struct {
+	// ResourceTypes holds default timeouts keyed by resource type, e.g. "aws_db_instance".
+	ResourceTypes map[string]Timeouts
+	// Services holds default timeouts keyed by service package name, e.g. "rds".
+	Services map[string]Timeouts
+}
+
+// For returns the default timeouts for a resource type implemented in a service package.
+// Timeouts set for the resource type take precedence over those set for the service.
+func (c *DefaultConfig) For(servicePackageName, typeName string) Timeouts {
+	if c == nil {
+		return Timeouts{}
+	}
+
+	return c.ResourceTypes[typeName].withDefaults(c.Services[servicePackageName])
+}
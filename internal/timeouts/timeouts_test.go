@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDefaultConfigFor(t *testing.T) {
+	t.Parallel()
+
+	config := &DefaultConfig{
+		ResourceTypes: map[string]Timeouts{
+			"aws_db_instance": {
+				Create: 120 * time.Minute,
+			},
+		},
+		Services: map[string]Timeouts{
+			"rds": {
+				Create: 90 * time.Minute,
+				Delete: 60 * time.Minute,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		config             *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               Timeouts
+	}{
+		"nil config": {
+			servicePackageName: "rds",
+			typeName:           "aws_db_instance",
+		},
+		"no match": {
+			config:             config,
+			servicePackageName: "eks",
+			typeName:           "aws_eks_cluster",
+		},
+		"service": {
+			config:             config,
+			servicePackageName: "rds",
+			typeName:           "aws_rds_cluster",
+			want: Timeouts{
+				Create: 90 * time.Minute,
+				Delete: 60 * time.Minute,
+			},
+		},
+		"resource type overrides service": {
+			config:             config,
+			servicePackageName: "rds",
+			typeName:           "aws_db_instance",
+			want: Timeouts{
+				Create: 120 * time.Minute,
+				Delete: 60 * time.Minute,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.config.For(testCase.servicePackageName, testCase.typeName), testCase.want; got != want {
+				t.Errorf("For(%q, %q) = %+v, want %+v", testCase.servicePackageName, testCase.typeName, got, want)
+			}
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	if _, ok := FromContext(ctx); ok {
+		t.Fatal("expected no timeouts in Context")
+	}
+
+	want := Timeouts{Update: time.Hour}
+	got, ok := FromContext(NewContext(ctx, want))
+	if !ok {
+		t.Fatal("expected timeouts in Context")
+	}
+	if got != want {
+		t.Errorf("FromContext() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+)
+
+// CIDRBlockAllocator allocates non-overlapping CIDR blocks from a parent CIDR block.
+// Allocations use best-fit packing: each block is carved from the smallest free block that can hold it,
+// preferring the lowest address, so the result depends only on the parent CIDR block and the order of allocations.
+type CIDRBlockAllocator struct {
+	parent netip.Prefix
+	free   []netip.Prefix
+}
+
+// NewCIDRBlockAllocator returns an allocator for the specified CIDR block.
+func NewCIDRBlockAllocator(cidr string) (*CIDRBlockAllocator, error) {
+	if err := ValidateCIDRBlock(cidr); err != nil {
+		return nil, err
+	}
+
+	parent, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, err
+	}
+	parent = parent.Masked()
+
+	return &CIDRBlockAllocator{
+		parent: parent,
+		free:   []netip.Prefix{parent},
+	}, nil
+}
+
+// IsIPv6 returns whether the allocator's parent CIDR block is an IPv6 CIDR block.
+func (a *CIDRBlockAllocator) IsIPv6() bool {
+	return a.parent.Addr().Is6()
+}
+
+// Allocate allocates a CIDR block with the specified prefix length.
+func (a *CIDRBlockAllocator) Allocate(prefixLength int) (string, error) {
+	if prefixLength < a.parent.Bits() || prefixLength > a.parent.Addr().BitLen() {
+		return "", fmt.Errorf("prefix length /%d is not within %s", prefixLength, a.parent)
+	}
+
+	best := -1
+	for i, v := range a.free {
+		if v.Bits() > prefixLength {
+			continue
+		}
+
+		if best == -1 || v.Bits() > a.free[best].Bits() || (v.Bits() == a.free[best].Bits() && v.Addr().Less(a.free[best].Addr())) {
+			best = i
+		}
+	}
+
+	if best == -1 {
+		return "", fmt.Errorf("insufficient free space in %s for a /%d CIDR block", a.parent, prefixLength)
+	}
+
+	block := a.free[best]
+	a.free = slices.Delete(a.free, best, best+1)
+
+	// Split the block in halves, keeping the lower half and returning the upper half to the free list.
+	// The upper halves are never adjacent to another free block of the same size, so the free list needs no coalescing.
+	for block.Bits() < prefixLength {
+		bits := block.Bits() + 1
+		a.free = append(a.free, netip.PrefixFrom(addAddr(block.Addr(), blockSize(block.Addr().BitLen(), bits)), bits))
+		block = netip.PrefixFrom(block.Addr(), bits)
+	}
+
+	return block.String(), nil
+}
+
+// FreeCIDRBlocks returns the unallocated CIDR blocks, ordered by address.
+func (a *CIDRBlockAllocator) FreeCIDRBlocks() []string {
+	free := slices.Clone(a.free)
+	slices.SortFunc(free, func(x, y netip.Prefix) int {
+		return x.Addr().Compare(y.Addr())
+	})
+
+	result := make([]string, len(free))
+	for i, v := range free {
+		result[i] = v.String()
+	}
+
+	return result
+}
+
+// FreeAddressCount returns the number of unallocated addresses.
+func (a *CIDRBlockAllocator) FreeAddressCount() *big.Int {
+	count := new(big.Int)
+
+	for _, v := range a.free {
+		count.Add(count, blockSize(v.Addr().BitLen(), v.Bits()))
+	}
+
+	return count
+}
+
+// blockSize returns the number of addresses in a CIDR block with the specified prefix length.
+func blockSize(bitLen, prefixLength int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(bitLen-prefixLength))
+}
+
+func addAddr(addr netip.Addr, n *big.Int) netip.Addr {
+	b := addr.AsSlice()
+	sum := new(big.Int).Add(new(big.Int).SetBytes(b), n)
+	result, _ := netip.AddrFromSlice(sum.FillBytes(make([]byte, len(b))))
+
+	return result
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCIDRBlockAllocator(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr          string
+		prefixLengths []int
+		expected      []string
+		expectedFree  []string
+		expectedCount string
+		expectError   bool
+	}{
+		{
+			cidr:          "10.0.0.0/16",
+			prefixLengths: []int{24, 24, 20},
+			expected:      []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.16.0/20"},
+			expectedFree:  []string{"10.0.2.0/23", "10.0.4.0/22", "10.0.8.0/21", "10.0.32.0/19", "10.0.64.0/18", "10.0.128.0/17"},
+			expectedCount: "60928",
+		},
+		{
+			// Best fit: the /26 is carved from the free /26 rather than splitting a larger block.
+			cidr:          "10.0.0.0/24",
+			prefixLengths: []int{26, 25, 26},
+			expected:      []string{"10.0.0.0/26", "10.0.0.128/25", "10.0.0.64/26"},
+			expectedFree:  []string{},
+			expectedCount: "0",
+		},
+		{
+			cidr:          "2001:db8::/56",
+			prefixLengths: []int{64, 60},
+			expected:      []string{"2001:db8::/64", "2001:db8:0:10::/60"},
+			expectedFree:  []string{"2001:db8:0:1::/64", "2001:db8:0:2::/63", "2001:db8:0:4::/62", "2001:db8:0:8::/61", "2001:db8:0:20::/59", "2001:db8:0:40::/58", "2001:db8:0:80::/57"},
+			expectedCount: "4408771833616582836224",
+		},
+		{
+			cidr:          "10.0.0.0/24",
+			prefixLengths: []int{25, 25, 28},
+			expectError:   true,
+		},
+		{
+			cidr:          "10.0.0.0/24",
+			prefixLengths: []int{16},
+			expectError:   true,
+		},
+	} {
+		a, err := NewCIDRBlockAllocator(ts.cidr)
+		if err != nil {
+			t.Fatalf("NewCIDRBlockAllocator(%q): %s", ts.cidr, err)
+		}
+
+		var got []string
+		for _, prefixLength := range ts.prefixLengths {
+			cidr, err := a.Allocate(prefixLength)
+			if err != nil {
+				if !ts.expectError {
+					t.Fatalf("Allocate(%d) in %q: %s", prefixLength, ts.cidr, err)
+				}
+				break
+			}
+			got = append(got, cidr)
+		}
+
+		if ts.expectError {
+			if len(got) == len(ts.prefixLengths) {
+				t.Fatalf("Allocate(%v) in %q should error but didn't", ts.prefixLengths, ts.cidr)
+			}
+			continue
+		}
+
+		if !slices.Equal(got, ts.expected) {
+			t.Errorf("Allocate(%v) in %q should be: %v, got: %v", ts.prefixLengths, ts.cidr, ts.expected, got)
+		}
+		if free := a.FreeCIDRBlocks(); !slices.Equal(free, ts.expectedFree) {
+			t.Errorf("FreeCIDRBlocks() in %q should be: %v, got: %v", ts.cidr, ts.expectedFree, free)
+		}
+		if count := a.FreeAddressCount().String(); count != ts.expectedCount {
+			t.Errorf("FreeAddressCount() in %q should be: %s, got: %s", ts.cidr, ts.expectedCount, count)
+		}
+	}
+}
+
+func TestNewCIDRBlockAllocator(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr  string
+		valid bool
+	}{
+		{"10.0.0.0/16", true},
+		{"2001:db8::/56", true},
+		{"10.0.0.1/16", false},
+		{"", false},
+	} {
+		_, err := NewCIDRBlockAllocator(ts.cidr)
+		if !ts.valid && err == nil {
+			t.Fatalf("Input '%s' should error but didn't!", ts.cidr)
+		}
+		if ts.valid && err != nil {
+			t.Fatalf("Got unexpected error for '%s' input: %s", ts.cidr, err)
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/openapi"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
@@ -84,6 +85,26 @@ func SuppressEquivalentJSONOrYAMLDiffs(k, old, new string, d *schema.ResourceDat
 	return normalizedOld == normalizedNew
 }
 
+// SuppressEquivalentOpenAPIDiffs returns a difference suppression function that compares
+// two OpenAPI documents in JSON or YAML format and returns `true` if they are semantically equivalent.
+func SuppressEquivalentOpenAPIDiffs(k, old, new string, d *schema.ResourceData) bool {
+	normalizedOld, err := openapi.Normalize(old)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to normalize Terraform state OpenAPI document: %s", err)
+		return false
+	}
+
+	normalizedNew, err := openapi.Normalize(new)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to normalize Terraform configuration OpenAPI document: %s", err)
+		return false
+	}
+
+	return normalizedOld == normalizedNew
+}
+
 func NormalizeJSONOrYAMLString(templateString any) (string, error) {
 	if v, ok := templateString.(string); ok {
 		templateString = strings.ReplaceAll(v, "\r\n", "\n")
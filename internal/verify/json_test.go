@@ -670,6 +670,65 @@ Outputs:
 	}
 }
 
+func TestSuppressEquivalentOpenAPIDiffs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		description string
+		equivalent  bool
+		old         string
+		new         string
+	}{
+		{
+			description: `JSON and YAML`,
+			equivalent:  true,
+			old:         `{"openapi":"3.0.1","info":{"title":"example","version":"1.0"},"paths":{"/":{"get":{"responses":{"200":{"description":"OK"}}}}}}`,
+			new: `
+openapi: 3.0.1
+info:
+  version: "1.0"
+  title: example
+paths:
+  /:
+    get:
+      responses:
+        200:
+          description: OK
+`,
+		},
+		{
+			description: `Extension order`,
+			equivalent:  true,
+			old:         `{"x-amazon-apigateway-api-key-source":"HEADER","x-amazon-apigateway-binary-media-types":["image/png","*/*"]}`,
+			new:         `{"x-amazon-apigateway-binary-media-types":["*/*","image/png"],"x-amazon-apigateway-api-key-source":"HEADER"}`,
+		},
+		{
+			description: `Different values`,
+			equivalent:  false,
+			old:         `{"openapi":"3.0.1","info":{"title":"example","version":"1.0"}}`,
+			new:         `{"openapi":"3.0.1","info":{"title":"example","version":"2.0"}}`,
+		},
+		{
+			description: `Invalid`,
+			equivalent:  false,
+			old:         `{"openapi":"3.0.1"}`,
+			new:         `{"openapi":`,
+		},
+	}
+
+	for _, tc := range testCases {
+		value := SuppressEquivalentOpenAPIDiffs("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !value {
+			t.Fatalf("expected test case (%s) to be equivalent", tc.description)
+		}
+
+		if !tc.equivalent && value {
+			t.Fatalf("expected test case (%s) to not be equivalent", tc.description)
+		}
+	}
+}
+
 func TestLegacyPolicyNormalize(t *testing.T) {
 	t.Parallel()
 
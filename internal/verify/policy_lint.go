@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+var globalConditionKeys = []string{
+	"aws:AssumedRoot",
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalTag/${TagKey}",
+	"aws:PrincipalType",
+	"aws:Referer",
+	"aws:RequestedRegion",
+	"aws:RequestTag/${TagKey}",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:ResourceTag/${TagKey}",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceVpc",
+	"aws:SourceVpcArn",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:userid",
+	"aws:username",
+	"aws:ViaAWSService",
+	"aws:VpceAccount",
+	"aws:VpceOrgID",
+	"aws:VpceOrgPaths",
+	"aws:VpcSourceIp",
+}
+
+// LintPolicy checks an IAM policy against the embedded catalog of IAM actions, resource types and condition keys and
+// returns warnings about unknown actions, resources whose ARN format doesn't match any resource type of an action and unsupported condition keys.
+// Actions and condition keys of services that aren't in the catalog can't be checked, and a warning that the service isn't covered is returned instead.
+func LintPolicy(policy string) ([]string, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+
+	var statements []map[string]any
+	if len(doc.Statement) > 0 && doc.Statement[0] == '{' {
+		var statement map[string]any
+		if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+			return nil, err
+		}
+		statements = append(statements, statement)
+	} else if len(doc.Statement) > 0 {
+		if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+			return nil, err
+		}
+	}
+
+	var warnings, uncovered []string
+	for i, statement := range statements {
+		name := fmt.Sprintf("statement %d", i)
+		if sid, ok := statement["Sid"].(string); ok && sid != "" {
+			name = fmt.Sprintf("statement %q", sid)
+		}
+
+		warn := func(format string, a ...any) {
+			warnings = append(warnings, name+": "+fmt.Sprintf(format, a...))
+		}
+
+		resources := lintPolicyStringList(statement["Resource"])
+		checkResources := len(resources) > 0 && !slices.Contains(resources, "*")
+
+		for _, key := range []string{"Action", "NotAction"} {
+			for _, action := range lintPolicyStringList(statement[key]) {
+				if action == "*" {
+					continue
+				}
+
+				servicePrefix, actionName, ok := strings.Cut(action, ":")
+				if !ok {
+					warn("invalid action %q", action)
+					continue
+				}
+
+				sa, ok := data.LookupServiceAuthorization(servicePrefix)
+				if !ok {
+					uncovered = append(uncovered, strings.ToLower(servicePrefix))
+					continue
+				}
+
+				if strings.ContainsAny(actionName, "*?") {
+					re := lintWildcardRegexp(actionName)
+					if !slices.ContainsFunc(slices.Collect(maps.Keys(sa.Actions)), re.MatchString) {
+						warn("action %q does not match any %s actions", action, servicePrefix)
+					}
+					continue
+				}
+
+				_, a, ok := sa.Action(actionName)
+				if !ok {
+					warn("unknown action %q", action)
+					continue
+				}
+
+				if key != "Action" || !checkResources || len(a.ResourceTypes) == 0 {
+					continue
+				}
+
+				var arnFormats []string
+				for _, resourceType := range a.ResourceTypes {
+					arnFormats = append(arnFormats, sa.ResourceTypes[resourceType]...)
+				}
+				if len(arnFormats) > 0 && !slices.ContainsFunc(resources, func(resource string) bool {
+					return slices.ContainsFunc(arnFormats, func(arnFormat string) bool {
+						return lintARNFormatMatches(arnFormat, resource)
+					})
+				}) {
+					warn("action %q does not apply to any of the statement's resources, expected ARNs of the form %s", action, strings.Join(arnFormats, ", "))
+				}
+			}
+		}
+
+		if conditions, ok := statement["Condition"].(map[string]any); ok {
+			var keys []string
+			for _, v := range conditions {
+				if v, ok := v.(map[string]any); ok {
+					keys = slices.AppendSeq(keys, maps.Keys(v))
+				}
+			}
+			slices.Sort(keys)
+
+			for _, key := range slices.Compact(keys) {
+				servicePrefix, _, ok := strings.Cut(key, ":")
+				if !ok {
+					warn("invalid condition key %q", key)
+					continue
+				}
+
+				var conditionKeys []string
+				if strings.EqualFold(servicePrefix, "aws") {
+					conditionKeys = globalConditionKeys
+				} else if sa, ok := data.LookupServiceAuthorization(servicePrefix); ok {
+					conditionKeys = sa.ConditionKeys
+				} else {
+					uncovered = append(uncovered, strings.ToLower(servicePrefix))
+					continue
+				}
+
+				if !slices.ContainsFunc(conditionKeys, func(conditionKey string) bool {
+					return lintConditionKeyMatches(conditionKey, key)
+				}) {
+					warn("unsupported condition key %q", key)
+				}
+			}
+		}
+	}
+
+	slices.Sort(uncovered)
+	for _, servicePrefix := range slices.Compact(uncovered) {
+		warnings = append(warnings, fmt.Sprintf("service %q is not covered by the catalog, its actions and condition keys were not checked", servicePrefix))
+	}
+
+	return warnings, nil
+}
+
+// lintConditionKeyMatches returns whether key matches the condition key, which may end with a variable, e.g. `aws:RequestTag/${TagKey}`.
+// Condition keys are case-insensitive.
+func lintConditionKeyMatches(conditionKey, key string) bool {
+	conditionKey, key = strings.ToLower(conditionKey), strings.ToLower(key)
+
+	if prefix, _, ok := strings.Cut(conditionKey, "${"); ok {
+		return len(key) > len(prefix) && strings.HasPrefix(key, prefix)
+	}
+
+	return conditionKey == key
+}
+
+// lintARNFormatMatches returns whether a policy resource can match ARNs of the specified format, e.g. `arn:${Partition}:s3:::${BucketName}/${ObjectName}`.
+// Wildcards and policy variables in the resource are tried as both a single path component and multiple path components.
+func lintARNFormatMatches(arnFormat, resource string) bool {
+	re := lintARNFormatRegexp(arnFormat)
+	variables := regexache.MustCompile(`\$\{[^}]*\}`)
+
+	for _, sample := range []string{"x", "x/x"} {
+		s := variables.ReplaceAllLiteralString(resource, sample)
+		s = strings.NewReplacer("*", sample, "?", "x").Replace(s)
+		if re.MatchString(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lintARNFormatRegexp returns a regular expression matching ARNs of the specified format.
+// A variable matches a single path component, unless it's the last variable and follows a '/' in which case it matches the rest of the ARN, e.g. an S3 object key.
+func lintARNFormatRegexp(arnFormat string) *regexp.Regexp {
+	variables := regexache.MustCompile(`\$\{([^}]*)\}`)
+	matches := variables.FindAllStringSubmatchIndex(arnFormat, -1)
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	last := 0
+	for i, m := range matches {
+		sb.WriteString(regexp.QuoteMeta(arnFormat[last:m[0]]))
+		switch name := arnFormat[m[2]:m[3]]; {
+		case name == "Partition":
+			sb.WriteString(`[^:]+`)
+		case name == "Region" || name == "Account":
+			sb.WriteString(`[^:]*`)
+		case i == len(matches)-1 && m[1] == len(arnFormat) && m[0] > 0 && arnFormat[m[0]-1] == '/':
+			sb.WriteString(`.+`)
+		default:
+			sb.WriteString(`[^/]+`)
+		}
+		last = m[1]
+	}
+	sb.WriteString(regexp.QuoteMeta(arnFormat[last:]))
+	sb.WriteString("$")
+
+	return regexache.MustCompile(sb.String())
+}
+
+// lintWildcardRegexp returns a case-insensitive regular expression matching the IAM wildcard pattern.
+func lintWildcardRegexp(pattern string) *regexp.Regexp {
+	s := regexp.QuoteMeta(pattern)
+	s = strings.NewReplacer(`\*`, `.*`, `\?`, `.`).Replace(s)
+
+	return regexache.MustCompile(`(?i)^` + s + `$`)
+}
+
+func lintPolicyStringList(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var out []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				out = append(out, v)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLintPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		expected []string
+	}{
+		"valid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": ["s3:GetObject", "s3:putobject"], "Resource": "arn:aws:s3:::test/*", "Condition": {"StringEquals": {"s3:x-amz-acl": "private", "aws:PrincipalTag/team": "a"}}},
+    {"Effect": "Allow", "Action": ["s3:ListBucket", "s3:Get*"], "Resource": ["arn:aws:s3:::test", "arn:aws:s3:::test/*"]},
+    {"Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "arn:aws:s3:::*"},
+    {"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "arn:aws:sqs:*:123456789012:test"},
+    {"Effect": "Allow", "Action": "ec2:DescribeInstancez", "Resource": "*", "Condition": {"StringEquals": {"ec2:NoSuchKey": "x"}}},
+    {"Effect": "Allow", "Action": "*", "Resource": "*"}
+  ]
+}`,
+			expected: []string{`service "ec2" is not covered by the catalog, its actions and condition keys were not checked`},
+		},
+		"single statement": {
+			policy:   `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "s3:GetObjects", "Resource": "*"}}`,
+			expected: []string{`statement 0: unknown action "s3:GetObjects"`},
+		},
+		"unknown actions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Typo", "Effect": "Allow", "Action": ["s3:GetObjects", "sqs:Send*", "s3:Gett*", "kms"], "Resource": "*"},
+    {"Effect": "Deny", "NotAction": "sts:AssumeRoles", "Resource": "*"}
+  ]
+}`,
+			expected: []string{
+				`statement "Typo": unknown action "s3:GetObjects"`,
+				`statement "Typo": action "s3:Gett*" does not match any s3 actions`,
+				`statement "Typo": invalid action "kms"`,
+				`statement 1: unknown action "sts:AssumeRoles"`,
+			},
+		},
+		"uncovered services": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": ["ec2:DescribeInstances", "EC2:DescribeVolumes", "s3:GetObjects"], "Resource": "*"},
+    {"Effect": "Allow", "Action": "dynamodb:GetItem", "Resource": "*", "Condition": {"StringEquals": {"ec2:Region": "us-west-2"}}}
+  ]
+}`,
+			expected: []string{
+				`statement 0: unknown action "s3:GetObjects"`,
+				`service "dynamodb" is not covered by the catalog, its actions and condition keys were not checked`,
+				`service "ec2" is not covered by the catalog, its actions and condition keys were not checked`,
+			},
+		},
+		"mismatched resources": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "arn:aws:s3:::test"},
+    {"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "arn:aws:s3:::test/*"},
+    {"Effect": "Allow", "Action": "sts:AssumeRole", "Resource": "arn:aws:iam::123456789012:user/test"}
+  ]
+}`,
+			expected: []string{
+				`statement 0: action "s3:GetObject" does not apply to any of the statement's resources, expected ARNs of the form arn:${Partition}:s3:::${BucketName}/${ObjectName}`,
+				`statement 1: action "s3:ListBucket" does not apply to any of the statement's resources, expected ARNs of the form arn:${Partition}:s3:::${BucketName}`,
+				`statement 2: action "sts:AssumeRole" does not apply to any of the statement's resources, expected ARNs of the form arn:${Partition}:iam::${Account}:role/${RoleNameWithPath}`,
+			},
+		},
+		"unsupported condition keys": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Condition": {"StringLike": {"s3:prefixes": "a/*", "aws:SourceIPs": "10.0.0.0/8", "aws:RequestTag/": "x"}}}
+  ]
+}`,
+			expected: []string{
+				`statement 0: unsupported condition key "aws:RequestTag/"`,
+				`statement 0: unsupported condition key "aws:SourceIPs"`,
+				`statement 0: unsupported condition key "s3:prefixes"`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := LintPolicy(testCase.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("expected:\n%q\ngot:\n%q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/go-cty/cty"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -473,6 +474,21 @@ func ValidDuration(v any, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidVersionConstraint validates a version constraint, e.g. `>= 1.2, < 2.0`.
+func ValidVersionConstraint(v any, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := gversion.NewConstraint(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a version constraint: %s", k, err))
+	}
+
+	return
+}
+
 // FloatGreaterThan returns a SchemaValidateFunc which tests if the provided value
 // is of type float and is greater than threshold.
 func FloatGreaterThan(threshold float64) schema.SchemaValidateFunc {
@@ -702,6 +702,36 @@ func TestValidUTCTimestamp(t *testing.T) {
 	}
 }
 
+func TestValidVersionConstraint(t *testing.T) {
+	t.Parallel()
+
+	validConstraints := []string{
+		"1.2.3",
+		">= 1.2, < 2.0",
+		"~> 15.4",
+		"!= 8.0.32",
+	}
+	for _, v := range validConstraints {
+		_, errors := ValidVersionConstraint(v, "version_constraint")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid version constraint: %q", v, errors)
+		}
+	}
+
+	invalidConstraints := []string{
+		"",
+		"latest",
+		">= 1.2,",
+		"=> 1.2",
+	}
+	for _, v := range invalidConstraints {
+		_, errors := ValidVersionConstraint(v, "version_constraint")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid version constraint", v)
+		}
+	}
+}
+
 func TestValidateTypeStringIsDateOrInt(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// ServiceAuthorization describes the actions, resource types and condition keys that a service supports in IAM policies.
+type ServiceAuthorization struct {
+	Actions       map[string]ServiceAuthorizationAction `json:"actions"`
+	ConditionKeys []string                              `json:"condition_keys"`
+	// ResourceTypes maps resource type names to their ARN formats, e.g. `arn:${Partition}:s3:::${BucketName}`.
+	ResourceTypes map[string][]string `json:"resource_types"`
+
+	actionsByLowerName map[string]string
+}
+
+type ServiceAuthorizationAction struct {
+	// ResourceTypes are the names of the resource types the action applies to.
+	// An action without resource types only supports "*" as its resource.
+	ResourceTypes []string `json:"resource_types,omitempty"`
+}
+
+// Action returns the action with the specified name. Action names are case-insensitive.
+func (sa *ServiceAuthorization) Action(name string) (string, ServiceAuthorizationAction, bool) {
+	name, ok := sa.actionsByLowerName[strings.ToLower(name)]
+	if !ok {
+		return "", ServiceAuthorizationAction{}, false
+	}
+
+	return name, sa.Actions[name], true
+}
+
+// LookupServiceAuthorization returns the service authorization information for the service with the specified IAM service prefix, e.g. `s3`.
+// Only services in the embedded catalog are found. The catalog covers the `kms`, `s3`, `sns`, `sqs` and `sts` services;
+// see `internal/generate/serviceauthorization` for how to extend it.
+func LookupServiceAuthorization(servicePrefix string) (*ServiceAuthorization, bool) {
+	catalog, err := readServiceAuthorizationCatalog()
+	if err != nil {
+		return nil, false
+	}
+
+	sa, ok := catalog[strings.ToLower(servicePrefix)]
+
+	return sa, ok
+}
+
+//go:embed service_authorization.json
+var serviceAuthorizationJSON []byte
+
+var readServiceAuthorizationCatalog = sync.OnceValues(func() (map[string]*ServiceAuthorization, error) {
+	var catalog map[string]*ServiceAuthorization
+	if err := json.Unmarshal(serviceAuthorizationJSON, &catalog); err != nil {
+		return nil, err
+	}
+
+	for _, sa := range catalog {
+		sa.actionsByLowerName = make(map[string]string, len(sa.Actions))
+		for name := range sa.Actions {
+			sa.actionsByLowerName[strings.ToLower(name)] = name
+		}
+	}
+
+	return catalog, nil
+})